@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+type config struct {
+	AccountID    string `yaml:"account_id"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	SDKKey       string `yaml:"sdk_key"`
+	SDKSecret    string `yaml:"sdk_secret"`
+}
+
+// loadConfig reads the config file at path, or the default config file when path is empty, and overrides its values
+// with any environment variables that are set. The default config file doesn't have to exist.
+func loadConfig(path string, getenv func(string) string) (*config, error) {
+	cfg := &config{}
+
+	if len(path) == 0 {
+		path = getenv("ZOOMCTL_CONFIG")
+	}
+
+	optional := false
+	if len(path) == 0 {
+		dir, err := os.UserConfigDir()
+		if err == nil {
+			path = filepath.Join(dir, "zoomctl", "config.yaml")
+			optional = true
+		}
+	}
+
+	if len(path) > 0 {
+		b, err := os.ReadFile(path)
+		if err != nil && !(optional && errors.Is(err, fs.ErrNotExist)) {
+			return nil, fmt.Errorf("reading config file: %w", err)
+		}
+
+		err = yaml.Unmarshal(b, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("decoding config file %s: %w", path, err)
+		}
+	}
+
+	for name, field := range map[string]*string{
+		"ZOOM_ACCOUNT_ID":    &cfg.AccountID,
+		"ZOOM_CLIENT_ID":     &cfg.ClientID,
+		"ZOOM_CLIENT_SECRET": &cfg.ClientSecret,
+		"ZOOM_SDK_KEY":       &cfg.SDKKey,
+		"ZOOM_SDK_SECRET":    &cfg.SDKSecret,
+	} {
+		if value := getenv(name); len(value) > 0 {
+			*field = value
+		}
+	}
+
+	return cfg, nil
+}
+
+func (c *config) validateAPI() error {
+	if len(c.AccountID) == 0 || len(c.ClientID) == 0 || len(c.ClientSecret) == 0 {
+		return errors.New("missing Server-to-Server OAuth credentials: set ZOOM_ACCOUNT_ID, ZOOM_CLIENT_ID and ZOOM_CLIENT_SECRET or add them to the config file")
+	}
+
+	return nil
+}
+
+func (c *config) validateSDK() error {
+	if len(c.SDKKey) == 0 || len(c.SDKSecret) == 0 {
+		return errors.New("missing Meeting SDK credentials: set ZOOM_SDK_KEY and ZOOM_SDK_SECRET or add them to the config file")
+	}
+
+	return nil
+}
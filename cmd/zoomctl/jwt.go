@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+func jwtCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("jwt")
+	meetingNumber := fs.Int64("meeting", 0, "meeting number (required)")
+	role := fs.Int("role", 0, "role: 0 participant, 1 host")
+	expiration := fs.Duration("expiration", 0, "how long the JWT is valid, between 30m and 48h (default 24h)")
+
+	_, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if *meetingNumber == 0 {
+		return errors.New("jwt: -meeting is required")
+	}
+
+	cfg, err := a.loadConfig()
+	if err != nil {
+		return err
+	}
+
+	err = cfg.validateSDK()
+	if err != nil {
+		return err
+	}
+
+	signed, err := zoom.MeetingSDKJWT(cfg.SDKKey, cfg.SDKSecret, *meetingNumber, *role, *expiration)
+	if err != nil {
+		return err
+	}
+
+	if *expiration == 0 {
+		*expiration = 24 * time.Hour
+	}
+
+	now := a.now()
+
+	return writeItem(a.out, a.format, newToken(signed, now, now.Add(*expiration), now), tokenColumns)
+}
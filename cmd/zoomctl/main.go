@@ -0,0 +1,244 @@
+// Command zoomctl scripts the Zoom API from the command line.
+//
+// Usage:
+//
+//	zoomctl [flags] <command> <action> [flags] [args]
+//
+// Credentials are read from the ZOOM_ACCOUNT_ID, ZOOM_CLIENT_ID, ZOOM_CLIENT_SECRET, ZOOM_SDK_KEY and ZOOM_SDK_SECRET
+// environment variables, falling back to a YAML config file with the keys account_id, client_id, client_secret,
+// sdk_key and sdk_secret. The config file defaults to zoomctl/config.yaml in the user's config directory and can be set
+// with -config or ZOOMCTL_CONFIG.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const usage = `Usage: zoomctl [flags] <command> <action> [flags] [args]
+
+Commands:
+  users list|get|create|delete
+  meetings list|get|create|delete
+  tokens fetch|show
+  jwt
+
+Flags:
+  -config string   path to the config file
+  -output string   output format: table, json or csv (default "table")
+  -all             follow pagination to list every page
+  -dry-run         print changes instead of making them
+`
+
+type app struct {
+	in     io.Reader
+	out    io.Writer
+	getenv func(string) string
+	now    func() time.Time
+
+	configPath string
+	format     string
+	all        bool
+	dryRun     bool
+
+	config   *config
+	client   *zoom.Client
+	users    zoom.UsersServicer
+	meetings zoom.MeetingsServicer
+}
+
+type command func(ctx context.Context, a *app, args []string) error
+
+var commands = map[string]map[string]command{
+	"users": {
+		"list":   usersList,
+		"get":    usersGet,
+		"create": usersCreate,
+		"delete": usersDelete,
+	},
+	"meetings": {
+		"list":   meetingsList,
+		"get":    meetingsGet,
+		"create": meetingsCreate,
+		"delete": meetingsDelete,
+	},
+	"tokens": {
+		"fetch": tokensFetch,
+		"show":  tokensShow,
+	},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := &app{
+		in:     os.Stdin,
+		out:    os.Stdout,
+		getenv: os.Getenv,
+		now:    time.Now,
+	}
+
+	err := a.run(ctx, os.Args[1:])
+	if err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "zoomctl:", err)
+		}
+
+		os.Exit(1)
+	}
+}
+
+func (a *app) run(ctx context.Context, args []string) error {
+	fs := a.flagSet("zoomctl")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	args = fs.Args()
+	if len(args) == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	if args[0] == "jwt" {
+		return jwtCreate(ctx, a, args[1:])
+	}
+
+	actions, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+
+	if len(args) < 2 {
+		return fmt.Errorf("%s: missing action", args[0])
+	}
+
+	cmd, ok := actions[args[1]]
+	if !ok {
+		return fmt.Errorf("%s: unknown action %q", args[0], args[1])
+	}
+
+	return cmd(ctx, a, args[2:])
+}
+
+// flagSet returns a flag set with the global flags registered, so they can be given before or after the command.
+func (a *app) flagSet(name string) *flag.FlagSet {
+	if len(a.format) == 0 {
+		a.format = formatTable
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(&a.configPath, "config", a.configPath, "path to the config file")
+	fs.StringVar(&a.format, "output", a.format, "output format: table, json or csv")
+	fs.BoolVar(&a.all, "all", a.all, "follow pagination to list every page")
+	fs.BoolVar(&a.dryRun, "dry-run", a.dryRun, "print changes instead of making them")
+
+	return fs
+}
+
+func (a *app) loadConfig() (*config, error) {
+	if a.config != nil {
+		return a.config, nil
+	}
+
+	cfg, err := loadConfig(a.configPath, a.getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	a.config = cfg
+
+	return cfg, nil
+}
+
+func (a *app) zoomClient() (*zoom.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	cfg, err := a.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.validateAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	a.client = zoom.NewClient(&http.Client{}, cfg.AccountID, cfg.ClientID, cfg.ClientSecret, nil)
+
+	return a.client, nil
+}
+
+func (a *app) usersService() (zoom.UsersServicer, error) {
+	if a.users != nil {
+		return a.users, nil
+	}
+
+	client, err := a.zoomClient()
+	if err != nil {
+		return nil, err
+	}
+
+	a.users = client.Users
+
+	return a.users, nil
+}
+
+func (a *app) meetingsService() (zoom.MeetingsServicer, error) {
+	if a.meetings != nil {
+		return a.meetings, nil
+	}
+
+	client, err := a.zoomClient()
+	if err != nil {
+		return nil, err
+	}
+
+	a.meetings = client.Meetings
+
+	return a.meetings, nil
+}
+
+// dryRunf reports a change that was not made because of -dry-run.
+func (a *app) dryRunf(format string, args ...any) {
+	fmt.Fprintf(a.out, "dry run: "+format+"\n", args...)
+}
+
+// parseArgs parses an action's flags and checks it was given one argument for each of argNames, which name them in
+// the usage.
+func parseArgs(fs *flag.FlagSet, args []string, argNames ...string) ([]string, error) {
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoomctl %s [flags] %s\n\nFlags:\n", fs.Name(), strings.Join(argNames, " "))
+		fs.PrintDefaults()
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if fs.NArg() != len(argNames) {
+		fs.Usage()
+		return nil, fmt.Errorf("%s: expected %d argument(s), got %d", fs.Name(), len(argNames), fs.NArg())
+	}
+
+	return fs.Args(), nil
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeUsers struct {
+	zoom.UsersServicer
+
+	pages   []*zoom.UsersListResponse
+	listed  []*zoom.UsersListOptions
+	created []*zoom.UsersCreateOptions
+}
+
+func (f *fakeUsers) List(ctx context.Context, opts *zoom.UsersListOptions) (*zoom.UsersListResponse, *http.Response, error) {
+	copied := *opts
+	f.listed = append(f.listed, &copied)
+
+	return f.pages[len(f.listed)-1], nil, nil
+}
+
+func (f *fakeUsers) Create(ctx context.Context, opts *zoom.UsersCreateOptions) (*zoom.UsersCreateResponse, *http.Response, error) {
+	f.created = append(f.created, opts)
+
+	return &zoom.UsersCreateResponse{ID: "u1", Email: opts.UserInfo.Email, Type: opts.UserInfo.Type}, nil, nil
+}
+
+type fakeMeetings struct {
+	zoom.MeetingsServicer
+
+	created []*zoom.MeetingsCreateOptions
+}
+
+func (f *fakeMeetings) Create(ctx context.Context, userID string, opts *zoom.MeetingsCreateOptions) (*zoom.MeetingsCreateResponse, *http.Response, error) {
+	f.created = append(f.created, opts)
+
+	return &zoom.MeetingsCreateResponse{ID: 123, Topic: *opts.Topic, StartTime: *opts.StartTime, Duration: *opts.Duration}, nil, nil
+}
+
+func newTestApp(t *testing.T, env map[string]string) (*app, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(configPath, nil, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &app{
+		in:  strings.NewReader(""),
+		out: out,
+		getenv: func(name string) string {
+			return env[name]
+		},
+		now:        time.Now,
+		configPath: configPath,
+	}, out
+}
+
+func TestUsersList(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := []*zoom.UsersListResponse{
+		{
+			PaginationResponse: &zoom.PaginationResponse{NextPageToken: "next"},
+			Users:              []*zoom.UsersListItem{{ID: "a", Email: "a@example.com", Type: 1, Status: "active"}},
+		},
+		{
+			PaginationResponse: &zoom.PaginationResponse{},
+			Users:              []*zoom.UsersListItem{{ID: "b", Email: "b,c@example.com", Type: 2, Status: "active"}},
+		},
+	}
+
+	users := &fakeUsers{pages: pages}
+	a, out := newTestApp(t, nil)
+	a.users = users
+
+	err := a.run(context.Background(), []string{"users", "list", "-status", "active"})
+	assert.NoError(err)
+	assert.Len(users.listed, 1)
+	assert.Equal("active", *users.listed[0].Status)
+	assert.Equal("id  email          first_name  last_name  type  status  dept\na   a@example.com                         1     active  \n", out.String())
+
+	users = &fakeUsers{pages: pages}
+	a, out = newTestApp(t, nil)
+	a.users = users
+
+	err = a.run(context.Background(), []string{"-output", "csv", "users", "list", "--all"})
+	assert.NoError(err)
+	assert.Len(users.listed, 2)
+	assert.Equal("next", *users.listed[1].NextPageToken)
+	assert.Equal("id,email,first_name,last_name,type,status,dept\na,a@example.com,,,1,active,\nb,\"b,c@example.com\",,,2,active,\n", out.String())
+}
+
+func TestUsersCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	users := &fakeUsers{}
+	a, out := newTestApp(t, nil)
+	a.users = users
+
+	err := a.run(context.Background(), []string{"users", "create", "-dry-run", "-email", "jane@example.com", "-type", "2"})
+	assert.NoError(err)
+	assert.Empty(users.created)
+	assert.Equal("dry run: would create user jane@example.com with action create and type 2\n", out.String())
+
+	out.Reset()
+	a.dryRun = false
+
+	err = a.run(context.Background(), []string{"users", "create", "-output", "json", "-email", "jane@example.com", "-first-name", "Jane"})
+	assert.NoError(err)
+	assert.Len(users.created, 1)
+	assert.Equal(zoom.ActionCreate, users.created[0].Action)
+	assert.Equal("Jane", *users.created[0].UserInfo.FirstName)
+	assert.Nil(users.created[0].UserInfo.LastName)
+	assert.Contains(out.String(), `"id": "u1"`)
+
+	err = a.run(context.Background(), []string{"users", "create"})
+	assert.ErrorContains(err, "-email is required")
+}
+
+func TestMeetingsCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	meetings := &fakeMeetings{}
+	a, out := newTestApp(t, nil)
+	a.meetings = meetings
+
+	err := a.run(context.Background(), []string{"meetings", "create", "-topic", "Standup", "-start", "2024-03-11T09:30", "-timezone", "America/New_York", "-duration", "15"})
+	assert.NoError(err)
+	assert.Len(meetings.created, 1)
+
+	opts := meetings.created[0]
+	assert.Equal("America/New_York", *opts.Timezone)
+	assert.Equal("America/New_York", opts.StartTime.Timezone)
+	assert.Equal(time.Date(2024, 3, 11, 13, 30, 0, 0, time.UTC), opts.StartTime.UTC())
+	assert.Contains(out.String(), "123  Standup")
+
+	_, err = parseStartTime("2024-03-11T09:30", "")
+	assert.ErrorContains(err, "set -timezone")
+
+	_, err = parseStartTime("2024-03-11T09:30", "America/Toronto")
+	assert.ErrorIs(err, zoom.ErrUnsupportedTimezone)
+}
+
+func TestRun_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	a, _ := newTestApp(t, nil)
+
+	assert.ErrorContains(a.run(context.Background(), []string{"groups", "list"}), `unknown command "groups"`)
+	assert.ErrorContains(a.run(context.Background(), []string{"users", "purge"}), `unknown action "purge"`)
+	assert.ErrorContains(a.run(context.Background(), []string{"meetings", "get"}), "expected 1 argument(s), got 0")
+	assert.ErrorContains(a.run(context.Background(), []string{"meetings", "get", "abc"}), `invalid meeting ID "abc"`)
+	assert.ErrorContains(a.run(context.Background(), []string{"users", "list"}), "missing Server-to-Server OAuth credentials")
+	assert.ErrorContains(a.run(context.Background(), []string{"-output", "xml", "tokens", "show", "-"}), "parsing token")
+
+	err := writeItem(&bytes.Buffer{}, "xml", &token{}, tokenColumns)
+	assert.ErrorContains(err, `unknown output format "xml"`)
+}
+
+func TestJWT(t *testing.T) {
+	assert := assert.New(t)
+
+	a, out := newTestApp(t, map[string]string{
+		"ZOOM_SDK_KEY":    "key",
+		"ZOOM_SDK_SECRET": "secret",
+	})
+
+	err := a.run(context.Background(), []string{"-output", "csv", "jwt", "-meeting", "123", "-role", "1", "-expiration", "2h"})
+	assert.NoError(err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(lines, 2)
+	tokenStr := strings.Split(lines[1], ",")[0]
+
+	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	})
+	assert.NoError(err)
+	assert.Equal(float64(123), token.Claims.(jwt.MapClaims)["mn"])
+
+	// Show the token as of two hours before it expires, so its expires_in doesn't depend on how long the test takes.
+	exp := int64(token.Claims.(jwt.MapClaims)["exp"].(float64))
+
+	a, out = newTestApp(t, nil)
+	a.in = strings.NewReader(tokenStr + "\n")
+	a.now = func() time.Time {
+		return time.Unix(exp, 0).Add(-2 * time.Hour)
+	}
+
+	err = a.run(context.Background(), []string{"tokens", "show", "-output", "json", "-"})
+	assert.NoError(err)
+	assert.Contains(out.String(), `"expires_in": "2h0m0s"`)
+	assert.NotContains(out.String(), "access_token")
+
+	a, _ = newTestApp(t, nil)
+	err = a.run(context.Background(), []string{"jwt", "-meeting", "123"})
+	assert.ErrorContains(err, "missing Meeting SDK credentials")
+}
+
+func TestLoadConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte("account_id: file-account\nclient_id: file-client\nclient_secret: file-secret\n"), 0o600)
+	assert.NoError(err)
+
+	cfg, err := loadConfig(path, func(name string) string {
+		if name == "ZOOM_CLIENT_ID" {
+			return "env-client"
+		}
+
+		return ""
+	})
+	assert.NoError(err)
+	assert.Equal(&config{AccountID: "file-account", ClientID: "env-client", ClientSecret: "file-secret"}, cfg)
+	assert.NoError(cfg.validateAPI())
+	assert.Error(cfg.validateSDK())
+
+	cfg, err = loadConfig("", func(name string) string {
+		return map[string]string{"ZOOMCTL_CONFIG": path}[name]
+	})
+	assert.NoError(err)
+	assert.Equal("file-client", cfg.ClientID)
+
+	_, err = loadConfig(filepath.Join(t.TempDir(), "missing.yaml"), func(string) string { return "" })
+	assert.ErrorContains(err, "reading config file")
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+var meetingColumns = []column[*zoom.MeetingsListItem]{
+	{"id", func(m *zoom.MeetingsListItem) string { return formatInt(m.ID) }},
+	{"topic", func(m *zoom.MeetingsListItem) string { return m.Topic }},
+	{"type", func(m *zoom.MeetingsListItem) string { return formatInt(m.Type) }},
+	{"start_time", func(m *zoom.MeetingsListItem) string { return formatTime(m.StartTime.Time) }},
+	{"duration", func(m *zoom.MeetingsListItem) string { return formatInt(m.Duration) }},
+	{"timezone", func(m *zoom.MeetingsListItem) string { return m.Timezone }},
+	{"join_url", func(m *zoom.MeetingsListItem) string { return m.JoinURL }},
+}
+
+func meetingsList(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("meetings list")
+	user := fs.String("user", "me", "user ID or email address of the host")
+	meetingType := fs.String("type", "", "meeting type: scheduled, live, upcoming, upcoming_meetings or previous_meetings")
+	pageSize := fs.Int("page-size", 300, "number of meetings per page")
+
+	_, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	meetings, err := a.meetingsService()
+	if err != nil {
+		return err
+	}
+
+	opts := &zoom.MeetingsListOptions{
+		PaginationOptions: &zoom.PaginationOptions{
+			PageSize: zoom.Ptr(*pageSize),
+		},
+	}
+	if len(*meetingType) > 0 {
+		opts.Type = meetingType
+	}
+
+	var items []*zoom.MeetingsListItem
+	for {
+		out, _, err := meetings.List(ctx, *user, opts)
+		if err != nil {
+			return fmt.Errorf("listing meetings: %w", err)
+		}
+
+		items = append(items, out.Meetings...)
+
+		if !a.all || out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+			break
+		}
+
+		opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+	}
+
+	return writeList(a.out, a.format, items, meetingColumns)
+}
+
+func meetingsGet(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("meetings get")
+
+	args, err := parseArgs(fs, args, "<meeting-id>")
+	if err != nil {
+		return err
+	}
+
+	meetingID, err := parseMeetingID(args[0])
+	if err != nil {
+		return err
+	}
+
+	meetings, err := a.meetingsService()
+	if err != nil {
+		return err
+	}
+
+	m, _, err := meetings.Get(ctx, meetingID, nil)
+	if err != nil {
+		return fmt.Errorf("getting meeting: %w", err)
+	}
+
+	return writeItem(a.out, a.format, m, []column[*zoom.MeetingsGetResponse]{
+		{"id", func(m *zoom.MeetingsGetResponse) string { return formatInt(m.ID) }},
+		{"topic", func(m *zoom.MeetingsGetResponse) string { return m.Topic }},
+		{"type", func(m *zoom.MeetingsGetResponse) string { return formatInt(m.Type) }},
+		{"status", func(m *zoom.MeetingsGetResponse) string { return m.Status }},
+		{"start_time", func(m *zoom.MeetingsGetResponse) string { return formatTime(m.StartTime.Time) }},
+		{"duration", func(m *zoom.MeetingsGetResponse) string { return formatInt(m.Duration) }},
+		{"timezone", func(m *zoom.MeetingsGetResponse) string { return m.Timezone }},
+		{"join_url", func(m *zoom.MeetingsGetResponse) string { return m.JoinURL }},
+	})
+}
+
+func meetingsCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("meetings create")
+	user := fs.String("user", "me", "user ID or email address of the host")
+	topic := fs.String("topic", "", "meeting topic (required)")
+	agenda := fs.String("agenda", "", "meeting agenda")
+	start := fs.String("start", "", "start time, either RFC 3339 or wall clock time in -timezone such as 2024-01-08T09:30 (required)")
+	timezone := fs.String("timezone", "", "IANA timezone of the meeting")
+	duration := fs.Int("duration", 30, "duration in minutes")
+
+	_, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(*topic) == 0 || len(*start) == 0 {
+		return errors.New("meetings create: -topic and -start are required")
+	}
+
+	startTime, err := parseStartTime(*start, *timezone)
+	if err != nil {
+		return err
+	}
+
+	opts := &zoom.MeetingsCreateOptions{
+		Duration:  duration,
+		StartTime: startTime,
+		Topic:     topic,
+		Type:      zoom.Ptr(zoom.MeetingTypeScheduled.Int()),
+	}
+	if len(*agenda) > 0 {
+		opts.Agenda = agenda
+	}
+	if len(*timezone) > 0 {
+		opts.Timezone = timezone
+	}
+
+	if a.dryRun {
+		a.dryRunf("would create meeting %q for %s at %s", *topic, *user, startTime.Format(time.RFC3339))
+		return nil
+	}
+
+	meetings, err := a.meetingsService()
+	if err != nil {
+		return err
+	}
+
+	m, _, err := meetings.Create(ctx, *user, opts)
+	if err != nil {
+		return fmt.Errorf("creating meeting: %w", err)
+	}
+
+	return writeItem(a.out, a.format, &zoom.MeetingsListItem{
+		Duration:  m.Duration,
+		ID:        m.ID,
+		JoinURL:   m.JoinURL,
+		StartTime: m.StartTime,
+		Timezone:  m.Timezone,
+		Topic:     m.Topic,
+		Type:      m.Type,
+	}, meetingColumns)
+}
+
+func meetingsDelete(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("meetings delete")
+	occurrenceID := fs.String("occurrence-id", "", "only delete this occurrence of a recurring meeting")
+
+	args, err := parseArgs(fs, args, "<meeting-id>")
+	if err != nil {
+		return err
+	}
+
+	meetingID, err := parseMeetingID(args[0])
+	if err != nil {
+		return err
+	}
+
+	if a.dryRun {
+		a.dryRunf("would delete meeting %d", meetingID)
+		return nil
+	}
+
+	meetings, err := a.meetingsService()
+	if err != nil {
+		return err
+	}
+
+	opts := &zoom.MeetingsDeleteOptions{}
+	if len(*occurrenceID) > 0 {
+		opts.OccurrenceID = occurrenceID
+	}
+
+	_, err = meetings.Delete(ctx, meetingID, opts)
+	if err != nil {
+		return fmt.Errorf("deleting meeting: %w", err)
+	}
+
+	return nil
+}
+
+func parseMeetingID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid meeting ID %q", s)
+	}
+
+	return id, nil
+}
+
+// parseStartTime parses an RFC 3339 time, or a wall clock time when timezone is set. With a timezone, the start time is
+// sent to Zoom as wall clock time in it.
+func parseStartTime(s, timezone string) (*zoom.StartTime, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err == nil {
+		if len(timezone) == 0 {
+			return zoom.NewStartTime(t), nil
+		}
+
+		return zoom.NewLocalStartTime(t, timezone)
+	}
+
+	if len(timezone) == 0 {
+		return nil, fmt.Errorf("invalid start time %q: use RFC 3339 or set -timezone", s)
+	}
+
+	loc, err := zoom.LoadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		t, err = time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			return zoom.NewLocalStartTime(t, timezone)
+		}
+	}
+
+	return nil, fmt.Errorf("invalid start time %q: use RFC 3339 or a wall clock time such as 2024-01-08T09:30", s)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+type column[T any] struct {
+	name  string
+	value func(T) string
+}
+
+// writeList writes items in the given format. JSON output is the items as returned by Zoom, while table and CSV output
+// only have the given columns.
+func writeList[T any](w io.Writer, format string, items []T, columns []column[T]) error {
+	if format == formatJSON {
+		if items == nil {
+			items = []T{}
+		}
+
+		return writeJSON(w, items)
+	}
+
+	return writeRows(w, format, items, columns)
+}
+
+// writeItem writes a single item in the given format.
+func writeItem[T any](w io.Writer, format string, item T, columns []column[T]) error {
+	if format == formatJSON {
+		return writeJSON(w, item)
+	}
+
+	return writeRows(w, format, []T{item}, columns)
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	err := enc.Encode(v)
+	if err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+
+	return nil
+}
+
+func writeRows[T any](w io.Writer, format string, items []T, columns []column[T]) error {
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+
+	rows := [][]string{header}
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, c := range columns {
+			row[i] = c.value(item)
+		}
+
+		rows = append(rows, row)
+	}
+
+	switch format {
+	case formatCSV:
+		cw := csv.NewWriter(w)
+
+		err := cw.WriteAll(rows)
+		if err != nil {
+			return fmt.Errorf("writing CSV: %w", err)
+		}
+
+		return nil
+	case formatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, row := range rows {
+			for i, value := range row {
+				if i > 0 {
+					fmt.Fprint(tw, "\t")
+				}
+
+				fmt.Fprint(tw, value)
+			}
+
+			fmt.Fprintln(tw)
+		}
+
+		return tw.Flush()
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func formatInt[T ~int | ~int64](i T) string {
+	return strconv.FormatInt(int64(i), 10)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type token struct {
+	AccessToken string    `json:"access_token,omitempty"`
+	IssuedAt    time.Time `json:"issued_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	ExpiresIn   string    `json:"expires_in"`
+}
+
+var tokenColumns = []column[*token]{
+	{"access_token", func(t *token) string { return t.AccessToken }},
+	{"issued_at", func(t *token) string { return formatTime(t.IssuedAt) }},
+	{"expires_at", func(t *token) string { return formatTime(t.ExpiresAt) }},
+	{"expires_in", func(t *token) string { return t.ExpiresIn }},
+}
+
+func newToken(accessToken string, issuedAt, expiresAt, now time.Time) *token {
+	return &token{
+		AccessToken: accessToken,
+		IssuedAt:    issuedAt,
+		ExpiresAt:   expiresAt,
+		ExpiresIn:   expiresAt.Sub(now).Round(time.Second).String(),
+	}
+}
+
+func tokensFetch(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("tokens fetch")
+
+	_, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	client, err := a.zoomClient()
+	if err != nil {
+		return err
+	}
+
+	accessToken, expiresAt, err := client.AccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	return writeItem(a.out, a.format, newToken(accessToken, a.now(), expiresAt, a.now()), tokenColumns)
+}
+
+// tokensShow shows when an access token or JWT was issued and expires. The token is read from stdin when the argument
+// is "-". Its signature isn't verified.
+func tokensShow(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("tokens show")
+
+	args, err := parseArgs(fs, args, "<token|->")
+	if err != nil {
+		return err
+	}
+
+	tokenStr := args[0]
+	if tokenStr == "-" {
+		b, err := io.ReadAll(a.in)
+		if err != nil {
+			return fmt.Errorf("reading token: %w", err)
+		}
+
+		tokenStr = string(b)
+	}
+
+	claims := jwt.MapClaims{}
+
+	_, _, err = jwt.NewParser().ParseUnverified(strings.TrimSpace(tokenStr), claims)
+	if err != nil {
+		return fmt.Errorf("parsing token: %w", err)
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("token has no expiration")
+	}
+
+	var issuedAt time.Time
+	if iat, ok := claims["iat"].(float64); ok {
+		issuedAt = time.Unix(int64(iat), 0)
+	}
+
+	return writeItem(a.out, a.format, newToken("", issuedAt, time.Unix(int64(exp), 0), a.now()), tokenColumns[1:])
+}
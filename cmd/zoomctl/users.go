@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+var userColumns = []column[*zoom.UsersListItem]{
+	{"id", func(u *zoom.UsersListItem) string { return u.ID }},
+	{"email", func(u *zoom.UsersListItem) string { return u.Email }},
+	{"first_name", func(u *zoom.UsersListItem) string { return u.FirstName }},
+	{"last_name", func(u *zoom.UsersListItem) string { return u.LastName }},
+	{"type", func(u *zoom.UsersListItem) string { return formatInt(u.Type) }},
+	{"status", func(u *zoom.UsersListItem) string { return u.Status }},
+	{"dept", func(u *zoom.UsersListItem) string { return u.Dept }},
+}
+
+func usersList(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("users list")
+	status := fs.String("status", "", "user status: active, inactive or pending")
+	roleID := fs.String("role-id", "", "only list users with this role")
+	pageSize := fs.Int("page-size", 300, "number of users per page")
+
+	_, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	users, err := a.usersService()
+	if err != nil {
+		return err
+	}
+
+	opts := &zoom.UsersListOptions{
+		PaginationOptions: &zoom.PaginationOptions{
+			PageSize: zoom.Ptr(*pageSize),
+		},
+	}
+	if len(*status) > 0 {
+		opts.Status = status
+	}
+	if len(*roleID) > 0 {
+		opts.RoleID = roleID
+	}
+
+	var items []*zoom.UsersListItem
+	for {
+		out, _, err := users.List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("listing users: %w", err)
+		}
+
+		items = append(items, out.Users...)
+
+		if !a.all || out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+			break
+		}
+
+		opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+	}
+
+	return writeList(a.out, a.format, items, userColumns)
+}
+
+func usersGet(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("users get")
+
+	args, err := parseArgs(fs, args, "<user-id|email>")
+	if err != nil {
+		return err
+	}
+
+	users, err := a.usersService()
+	if err != nil {
+		return err
+	}
+
+	user, _, err := users.Get(ctx, args[0], nil)
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+
+	return writeItem(a.out, a.format, &user.UsersListItem, userColumns)
+}
+
+func usersCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("users create")
+	email := fs.String("email", "", "email address (required)")
+	firstName := fs.String("first-name", "", "first name")
+	lastName := fs.String("last-name", "", "last name")
+	userType := fs.Int("type", 1, "user type: 1 basic, 2 licensed")
+	action := fs.String("action", zoom.ActionCreate.String(), "create action: create, autoCreate, custCreate or ssoCreate")
+
+	_, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(*email) == 0 {
+		return errors.New("users create: -email is required")
+	}
+
+	opts := &zoom.UsersCreateOptions{
+		Action: zoom.UsersCreateAction(*action),
+		UserInfo: &zoom.UsersCreateOptionsUserInfo{
+			Email: *email,
+			Type:  *userType,
+		},
+	}
+	if len(*firstName) > 0 {
+		opts.UserInfo.FirstName = firstName
+	}
+	if len(*lastName) > 0 {
+		opts.UserInfo.LastName = lastName
+	}
+
+	if a.dryRun {
+		a.dryRunf("would create user %s with action %s and type %d", *email, *action, *userType)
+		return nil
+	}
+
+	users, err := a.usersService()
+	if err != nil {
+		return err
+	}
+
+	out, _, err := users.Create(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("creating user: %w", err)
+	}
+
+	return writeItem(a.out, a.format, &zoom.UsersListItem{
+		Email:     out.Email,
+		FirstName: out.FirstName,
+		ID:        out.ID,
+		LastName:  out.LastName,
+		Type:      out.Type,
+	}, userColumns)
+}
+
+func usersDelete(ctx context.Context, a *app, args []string) error {
+	fs := a.flagSet("users delete")
+	action := fs.String("action", "disassociate", "delete action: disassociate or delete")
+
+	args, err := parseArgs(fs, args, "<user-id|email>")
+	if err != nil {
+		return err
+	}
+
+	if a.dryRun {
+		a.dryRunf("would %s user %s", *action, args[0])
+		return nil
+	}
+
+	users, err := a.usersService()
+	if err != nil {
+		return err
+	}
+
+	_, err = users.Delete(ctx, args[0], &zoom.UsersDeleteOptions{
+		Action: action,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting user: %w", err)
+	}
+
+	return nil
+}
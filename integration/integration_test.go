@@ -95,7 +95,7 @@ func TestMeetingsCreate(t *testing.T) {
 	duration := 30
 	jbhTime := 0
 	joinBeforeHost := true
-	startTime := time.Now().UTC()
+	startTime := time.Now().UTC().Truncate(time.Minute)
 	meetingType := 2
 
 	createRes, _, err := client.Meetings.Create(context.Background(), adminUserID, &zoom.MeetingsCreateOptions{
@@ -105,7 +105,7 @@ func TestMeetingsCreate(t *testing.T) {
 			JBHTime:        zoom.Ptr(jbhTime),
 			JoinBeforeHost: zoom.Ptr(joinBeforeHost),
 		},
-		StartTime: zoom.Ptr(zoom.MeetingsCreateOptionsStartTime(startTime)),
+		StartTime: zoom.NewStartTime(startTime),
 		Type:      zoom.Ptr(meetingType),
 	})
 	if err != nil {
@@ -115,6 +115,6 @@ func TestMeetingsCreate(t *testing.T) {
 	assert.Equal(duration, createRes.Duration)
 	assert.Equal(jbhTime, createRes.Settings.JbhTime)
 	assert.Equal(joinBeforeHost, createRes.Settings.JoinBeforeHost)
-	assert.Equal(startTime.Truncate(time.Minute), createRes.StartTime)
+	assert.Equal(startTime, createRes.StartTime.Time)
 	assert.Equal(meetingType, createRes.Type)
 }
@@ -0,0 +1,307 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// AccountMe refers to the account that owns the Server-to-Server OAuth app.
+	AccountMe = "me"
+
+	AccountsSettingsOptionMeetingAuthentication   AccountsSettingsOption = "meeting_authentication"
+	AccountsSettingsOptionRecordingAuthentication AccountsSettingsOption = "recording_authentication"
+	AccountsSettingsOptionSecurity                AccountsSettingsOption = "security"
+	AccountsSettingsOptionMeetingSecurity         AccountsSettingsOption = "meeting_security"
+
+	AccountsAuthenticationOptionActionAdd    = "add"
+	AccountsAuthenticationOptionActionUpdate = "update"
+	AccountsAuthenticationOptionActionDelete = "delete"
+	AccountsAuthenticationOptionActionShow   = "show"
+	AccountsAuthenticationOptionActionHide   = "hide"
+)
+
+// AccountsSettingsOption selects a subset of account settings to get or update.
+type AccountsSettingsOption string
+
+func (a AccountsSettingsOption) String() string {
+	return string(a)
+}
+
+type AccountsServicer interface {
+	GetSettings(ctx context.Context, accountID string, opts *AccountsGetSettingsOptions) (*Settings, *http.Response, error)
+	UpdateSettings(ctx context.Context, accountID string, opts *AccountsUpdateSettingsOptions) (*http.Response, error)
+	GetAuthenticationSettings(ctx context.Context, accountID string, option AccountsSettingsOption) (*AccountsAuthenticationSettings, *http.Response, error)
+	UpdateAuthenticationSettings(ctx context.Context, accountID string, option AccountsSettingsOption, opts *AccountsUpdateAuthenticationSettingsOptions) (*http.Response, error)
+	GetLockSettings(ctx context.Context, accountID string, opts *AccountsGetSettingsOptions) (*Settings, *http.Response, error)
+	UpdateLockSettings(ctx context.Context, accountID string, opts *Settings) (*http.Response, error)
+	ListManagedDomains(ctx context.Context, accountID string) (*AccountsListManagedDomainsResponse, *http.Response, error)
+	ListTrustedDomains(ctx context.Context, accountID string) (*AccountsListTrustedDomainsResponse, *http.Response, error)
+
+	List(ctx context.Context, opts *PaginationOptions) (*AccountsListResponse, *http.Response, error)
+	Get(ctx context.Context, accountID string) (*Account, *http.Response, error)
+	Create(ctx context.Context, opts *AccountsCreateOptions) (*AccountsCreateResponse, *http.Response, error)
+	UpdateOptions(ctx context.Context, accountID string, opts *AccountOptions) (*http.Response, error)
+	Delete(ctx context.Context, accountID string) (*http.Response, error)
+}
+
+// AccountsService covers account settings and, for master accounts, sub-account management.
+// Pass AccountMe as the account ID to manage the account the client authenticates as.
+type AccountsService struct {
+	client *Client
+}
+
+var _ AccountsServicer = (*AccountsService)(nil)
+
+func accountPath(accountID string) string {
+	return "/accounts/" + url.QueryEscape(accountID)
+}
+
+type AccountsGetSettingsOptions struct {
+	CustomQueryFields *string                 `url:"custom_query_fields,omitempty"`
+	Option            *AccountsSettingsOption `url:"option,omitempty"`
+}
+
+// GetSettings returns the account's settings. With the security or meeting_security option only that section is returned.
+// Use GetAuthenticationSettings for the meeting_authentication and recording_authentication options.
+func (a *AccountsService) GetSettings(ctx context.Context, accountID string, opts *AccountsGetSettingsOptions) (*Settings, *http.Response, error) {
+	out := &Settings{}
+
+	res, err := a.client.request(ctx, http.MethodGet, accountPath(accountID)+"/settings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type AccountsUpdateSettingsOptions struct {
+	*Settings `url:"-"`
+
+	Option *AccountsSettingsOption `url:"option,omitempty" json:"-"`
+}
+
+func (a *AccountsService) UpdateSettings(ctx context.Context, accountID string, opts *AccountsUpdateSettingsOptions) (*http.Response, error) {
+	res, err := a.client.request(ctx, http.MethodPatch, accountPath(accountID)+"/settings", opts, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type AccountsAuthenticationSettings struct {
+	AuthenticationOptions   []*AccountsAuthenticationOption `json:"authentication_options"`
+	MeetingAuthentication   bool                            `json:"meeting_authentication"`
+	RecordingAuthentication bool                            `json:"recording_authentication"`
+}
+
+type AccountsAuthenticationOption struct {
+	DefaultOption bool   `json:"default_option"`
+	Domains       string `json:"domains"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Visible       bool   `json:"visible"`
+}
+
+type authenticationSettingsOptions struct {
+	Option AccountsSettingsOption `url:"option"`
+}
+
+// GetAuthenticationSettings returns the meeting or recording authentication settings, depending on option.
+func (a *AccountsService) GetAuthenticationSettings(ctx context.Context, accountID string, option AccountsSettingsOption) (*AccountsAuthenticationSettings, *http.Response, error) {
+	out := &AccountsAuthenticationSettings{}
+
+	res, err := a.client.request(ctx, http.MethodGet, accountPath(accountID)+"/settings", &authenticationSettingsOptions{Option: option}, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type AccountsUpdateAuthenticationSettingsOptions struct {
+	AuthenticationOption *AccountsUpdateAuthenticationSettingsOptionsOption `json:"authentication_option,omitempty"`
+}
+
+type AccountsUpdateAuthenticationSettingsOptionsOption struct {
+	Action        string  `json:"action"`
+	DefaultOption *bool   `json:"default_option,omitempty"`
+	Domains       *string `json:"domains,omitempty"`
+	ID            *string `json:"id,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Type          *string `json:"type,omitempty"`
+}
+
+// UpdateAuthenticationSettings adds, updates, deletes, shows or hides a meeting or recording authentication option, depending on option.
+func (a *AccountsService) UpdateAuthenticationSettings(ctx context.Context, accountID string, option AccountsSettingsOption, opts *AccountsUpdateAuthenticationSettingsOptions) (*http.Response, error) {
+	res, err := a.client.request(ctx, http.MethodPatch, accountPath(accountID)+"/settings", &authenticationSettingsOptions{Option: option}, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (a *AccountsService) GetLockSettings(ctx context.Context, accountID string, opts *AccountsGetSettingsOptions) (*Settings, *http.Response, error) {
+	out := &Settings{}
+
+	res, err := a.client.request(ctx, http.MethodGet, accountPath(accountID)+"/lock_settings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (a *AccountsService) UpdateLockSettings(ctx context.Context, accountID string, opts *Settings) (*http.Response, error) {
+	res, err := a.client.request(ctx, http.MethodPatch, accountPath(accountID)+"/lock_settings", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type AccountsListManagedDomainsResponse struct {
+	Domains      []*AccountsManagedDomain `json:"domains"`
+	TotalRecords int                      `json:"total_records"`
+}
+
+type AccountsManagedDomain struct {
+	Domain string `json:"domain"`
+	Status string `json:"status"`
+}
+
+func (a *AccountsService) ListManagedDomains(ctx context.Context, accountID string) (*AccountsListManagedDomainsResponse, *http.Response, error) {
+	out := &AccountsListManagedDomainsResponse{}
+
+	res, err := a.client.request(ctx, http.MethodGet, accountPath(accountID)+"/managed_domains", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type AccountsListTrustedDomainsResponse struct {
+	TrustedDomains []string `json:"trusted_domains"`
+}
+
+func (a *AccountsService) ListTrustedDomains(ctx context.Context, accountID string) (*AccountsListTrustedDomainsResponse, *http.Response, error) {
+	out := &AccountsListTrustedDomainsResponse{}
+
+	res, err := a.client.request(ctx, http.MethodGet, accountPath(accountID)+"/trusted_domains", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type AccountsListResponse struct {
+	*PaginationResponse
+
+	Accounts []*Account `json:"accounts"`
+}
+
+type Account struct {
+	AccountName           string          `json:"account_name"`
+	AccountNumber         string          `json:"account_number"`
+	AccountType           string          `json:"account_type"`
+	CreatedAt             time.Time       `json:"created_at"`
+	ID                    string          `json:"id"`
+	Options               *AccountOptions `json:"options"`
+	OwnerEmail            string          `json:"owner_email"`
+	OwnerID               string          `json:"owner_id"`
+	Seats                 int             `json:"seats"`
+	SubscriptionEndTime   time.Time       `json:"subscription_end_time"`
+	SubscriptionStartTime time.Time       `json:"subscription_start_time"`
+	VanityURL             string          `json:"vanity_url"`
+}
+
+// AccountOptions is used both to read and to update a sub-account's options.
+type AccountOptions struct {
+	BillingAutoRenew  *bool   `json:"billing_auto_renew,omitempty"`
+	MeetingConnectors *string `json:"meeting_connectors,omitempty"`
+	PayMode           *string `json:"pay_mode,omitempty"`
+	RoomConnectors    *string `json:"room_connectors,omitempty"`
+	ShareMC           *bool   `json:"share_mc,omitempty"`
+	ShareRC           *bool   `json:"share_rc,omitempty"`
+}
+
+// List lists the sub-accounts of a master account.
+func (a *AccountsService) List(ctx context.Context, opts *PaginationOptions) (*AccountsListResponse, *http.Response, error) {
+	out := &AccountsListResponse{}
+
+	res, err := a.client.request(ctx, http.MethodGet, "/accounts", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (a *AccountsService) Get(ctx context.Context, accountID string) (*Account, *http.Response, error) {
+	out := &Account{}
+
+	res, err := a.client.request(ctx, http.MethodGet, accountPath(accountID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type AccountsCreateOptions struct {
+	AccountName  *string         `json:"account_name,omitempty"`
+	Email        string          `json:"email"`
+	FirstName    string          `json:"first_name"`
+	LastName     string          `json:"last_name"`
+	Options      *AccountOptions `json:"options,omitempty"`
+	Password     string          `json:"password"`
+	PhoneCountry *string         `json:"phone_country,omitempty"`
+	PhoneNumber  *string         `json:"phone_number,omitempty"`
+}
+
+type AccountsCreateResponse struct {
+	CreatedAt  time.Time `json:"created_at"`
+	ID         string    `json:"id"`
+	OwnerEmail string    `json:"owner_email"`
+	OwnerID    string    `json:"owner_id"`
+}
+
+// Create creates a sub-account under a master account.
+func (a *AccountsService) Create(ctx context.Context, opts *AccountsCreateOptions) (*AccountsCreateResponse, *http.Response, error) {
+	out := &AccountsCreateResponse{}
+
+	res, err := a.client.request(ctx, http.MethodPost, "/accounts", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (a *AccountsService) UpdateOptions(ctx context.Context, accountID string, opts *AccountOptions) (*http.Response, error) {
+	res, err := a.client.request(ctx, http.MethodPatch, accountPath(accountID)+"/options", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// Delete disassociates a sub-account from its master account.
+func (a *AccountsService) Delete(ctx context.Context, accountID string) (*http.Response, error) {
+	res, err := a.client.request(ctx, http.MethodDelete, accountPath(accountID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,516 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	ChatChannelTypePrivate ChatChannelType = 1
+	// ChatChannelTypePrivateAccount is a private channel whose members all belong to the same account.
+	ChatChannelTypePrivateAccount ChatChannelType = 2
+	ChatChannelTypePublic         ChatChannelType = 3
+	// ChatChannelTypeInstant is a group chat without a name that is not listed as a channel.
+	ChatChannelTypeInstant ChatChannelType = 4
+
+	ChatChannelRoleOwner  ChatChannelRole = "owner"
+	ChatChannelRoleAdmin  ChatChannelRole = "admin"
+	ChatChannelRoleMember ChatChannelRole = "member"
+
+	ChatFormatTypeBold            ChatFormatType = "Bold"
+	ChatFormatTypeItalic          ChatFormatType = "Italic"
+	ChatFormatTypeStrikethrough   ChatFormatType = "Strikethrough"
+	ChatFormatTypeUnderline       ChatFormatType = "Underline"
+	ChatFormatTypeBulletedList    ChatFormatType = "BulletedList"
+	ChatFormatTypeNumberedList    ChatFormatType = "NumberedList"
+	ChatFormatTypeQuote           ChatFormatType = "Quote"
+	ChatFormatTypeParagraph       ChatFormatType = "Paragraph"
+	ChatFormatTypeLeftIndent      ChatFormatType = "LeftIndent"
+	ChatFormatTypeFontSize        ChatFormatType = "FontSize"
+	ChatFormatTypeFontColor       ChatFormatType = "FontColor"
+	ChatFormatTypeBackgroundColor ChatFormatType = "BackgroundColor"
+	// ChatFormatTypeAddLink links the text to the URL in FormatAttr.
+	ChatFormatTypeAddLink ChatFormatType = "AddLink"
+
+	// ChatAtTypeContact mentions the contact in AtContact.
+	ChatAtTypeContact ChatAtType = 1
+	// ChatAtTypeAll mentions every member of the channel.
+	ChatAtTypeAll ChatAtType = 2
+
+	ChatReactionActionAdd    ChatReactionAction = "add"
+	ChatReactionActionRemove ChatReactionAction = "remove"
+)
+
+type ChatChannelType int
+
+func (c ChatChannelType) Int() int {
+	return int(c)
+}
+
+type ChatChannelRole string
+
+func (c ChatChannelRole) String() string {
+	return string(c)
+}
+
+// ChatFormatType is how rich text styles part of a message.
+type ChatFormatType string
+
+func (c ChatFormatType) String() string {
+	return string(c)
+}
+
+// ChatAtType is who a message mentions.
+type ChatAtType int
+
+func (c ChatAtType) Int() int {
+	return int(c)
+}
+
+type ChatReactionAction string
+
+func (c ChatReactionAction) String() string {
+	return string(c)
+}
+
+type ChatServicer interface {
+	ListChannels(ctx context.Context, userID string, opts *PaginationOptions) (*ChatListChannelsResponse, *http.Response, error)
+	GetChannel(ctx context.Context, channelID string) (*ChatChannel, *http.Response, error)
+	CreateChannel(ctx context.Context, userID string, opts *ChatCreateChannelOptions) (*ChatChannel, *http.Response, error)
+	UpdateChannel(ctx context.Context, channelID string, opts *ChatUpdateChannelOptions) (*http.Response, error)
+	DeleteChannel(ctx context.Context, channelID string) (*http.Response, error)
+
+	ListChannelMembers(ctx context.Context, channelID string, opts *PaginationOptions) (*ChatListChannelMembersResponse, *http.Response, error)
+	AddChannelMembers(ctx context.Context, channelID string, opts *ChatAddChannelMembersOptions) (*ChatAddChannelMembersResponse, *http.Response, error)
+	RemoveChannelMember(ctx context.Context, channelID string, memberID string) (*http.Response, error)
+
+	ListMessages(ctx context.Context, userID string, opts *ChatListMessagesOptions) (*ChatListMessagesResponse, *http.Response, error)
+	ListMessagesAll(ctx context.Context, userID string, opts *ChatListMessagesOptions) ([]*ChatMessage, error)
+	GetMessage(ctx context.Context, userID string, messageID string, opts *ChatMessageOptions) (*ChatMessage, *http.Response, error)
+	SendMessage(ctx context.Context, userID string, opts *ChatSendMessageOptions) (*ChatSendMessageResponse, *http.Response, error)
+	UpdateMessage(ctx context.Context, userID string, messageID string, opts *ChatUpdateMessageOptions) (*http.Response, error)
+	DeleteMessage(ctx context.Context, userID string, messageID string, opts *ChatMessageOptions) (*http.Response, error)
+	React(ctx context.Context, userID string, messageID string, opts *ChatReactOptions) (*http.Response, error)
+
+	UploadFile(ctx context.Context, userID string, fileName string, r io.Reader) (*ChatUploadFileResponse, *http.Response, error)
+	SendFile(ctx context.Context, userID string, fileName string, r io.Reader, opts *ChatSendFileOptions) (*ChatSendMessageResponse, *http.Response, error)
+}
+
+// ChatService covers Team Chat channels and messages. Messages are sent and read as the user whose ID is given, and go
+// to either a channel or a contact.
+type ChatService struct {
+	client *Client
+}
+
+var _ ChatServicer = (*ChatService)(nil)
+
+func chatChannelPath(channelID string) string {
+	return "/chat/channels/" + url.QueryEscape(channelID)
+}
+
+func chatMessagesPath(userID string) string {
+	return "/chat/users/" + url.QueryEscape(userID) + "/messages"
+}
+
+type ChatListChannelsResponse struct {
+	*PaginationResponse
+
+	Channels []*ChatChannel `json:"channels"`
+}
+
+type ChatChannel struct {
+	ChannelSettings *ChatChannelSettings `json:"channel_settings"`
+	ChannelURL      string               `json:"channel_url"`
+	ID              string               `json:"id"`
+	JID             string               `json:"jid"`
+	Name            string               `json:"name"`
+	Type            ChatChannelType      `json:"type"`
+}
+
+type ChatChannelSettings struct {
+	AddMemberPermissions                   *int  `json:"add_member_permissions,omitempty"`
+	AllowToAddExternalUsers                *int  `json:"allow_to_add_external_users,omitempty"`
+	AllowToCreatePublicChannelsForExternal *bool `json:"allow_to_create_public_channels_for_external,omitempty"`
+	MentionAllPermissions                  *int  `json:"mention_all_permissions,omitempty"`
+	NewMembersCanSeePreviousMessagesFiles  *bool `json:"new_members_can_see_previous_messages_files,omitempty"`
+	PostingPermissions                     *int  `json:"posting_permissions,omitempty"`
+}
+
+// ListChannels lists the channels a user is a member of.
+func (c *ChatService) ListChannels(ctx context.Context, userID string, opts *PaginationOptions) (*ChatListChannelsResponse, *http.Response, error) {
+	out := &ChatListChannelsResponse{}
+
+	res, err := c.client.request(ctx, http.MethodGet, "/chat/users/"+url.QueryEscape(userID)+"/channels", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (c *ChatService) GetChannel(ctx context.Context, channelID string) (*ChatChannel, *http.Response, error) {
+	out := &ChatChannel{}
+
+	res, err := c.client.request(ctx, http.MethodGet, chatChannelPath(channelID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ChatCreateChannelOptions struct {
+	ChannelSettings *ChatChannelSettings `json:"channel_settings,omitempty"`
+	Members         []*ChatChannelInvite `json:"members,omitempty"`
+	Name            *string              `json:"name,omitempty"`
+	Type            ChatChannelType      `json:"type"`
+}
+
+type ChatChannelInvite struct {
+	Email string `json:"email"`
+}
+
+// CreateChannel creates a channel owned by a user, inviting up to 20 members with it.
+func (c *ChatService) CreateChannel(ctx context.Context, userID string, opts *ChatCreateChannelOptions) (*ChatChannel, *http.Response, error) {
+	out := &ChatChannel{}
+
+	res, err := c.client.request(ctx, http.MethodPost, "/chat/users/"+url.QueryEscape(userID)+"/channels", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ChatUpdateChannelOptions struct {
+	ChannelSettings *ChatChannelSettings `json:"channel_settings,omitempty"`
+	Name            *string              `json:"name,omitempty"`
+	Type            *ChatChannelType     `json:"type,omitempty"`
+}
+
+func (c *ChatService) UpdateChannel(ctx context.Context, channelID string, opts *ChatUpdateChannelOptions) (*http.Response, error) {
+	res, err := c.client.request(ctx, http.MethodPatch, chatChannelPath(channelID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (c *ChatService) DeleteChannel(ctx context.Context, channelID string) (*http.Response, error) {
+	res, err := c.client.request(ctx, http.MethodDelete, chatChannelPath(channelID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type ChatListChannelMembersResponse struct {
+	*PaginationResponse
+
+	Members []*ChatChannelMember `json:"members"`
+}
+
+type ChatChannelMember struct {
+	Email      string          `json:"email"`
+	FirstName  string          `json:"first_name"`
+	ID         string          `json:"id"`
+	IsExternal bool            `json:"is_external"`
+	LastName   string          `json:"last_name"`
+	MemberID   string          `json:"member_id"`
+	Name       string          `json:"name"`
+	Role       ChatChannelRole `json:"role"`
+}
+
+func (c *ChatService) ListChannelMembers(ctx context.Context, channelID string, opts *PaginationOptions) (*ChatListChannelMembersResponse, *http.Response, error) {
+	out := &ChatListChannelMembersResponse{}
+
+	res, err := c.client.request(ctx, http.MethodGet, chatChannelPath(channelID)+"/members", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ChatAddChannelMembersOptions struct {
+	Members []*ChatChannelInvite `json:"members"`
+}
+
+type ChatAddChannelMembersResponse struct {
+	AddedAt time.Time `json:"added_at"`
+	// IDs is a comma separated list of the IDs of the added members.
+	IDs string `json:"ids"`
+}
+
+// AddChannelMembers invites up to 5 members to a channel at a time.
+func (c *ChatService) AddChannelMembers(ctx context.Context, channelID string, opts *ChatAddChannelMembersOptions) (*ChatAddChannelMembersResponse, *http.Response, error) {
+	out := &ChatAddChannelMembersResponse{}
+
+	res, err := c.client.request(ctx, http.MethodPost, chatChannelPath(channelID)+"/members", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// RemoveChannelMember removes a member from a channel. memberID is the member's ID, not their user ID.
+func (c *ChatService) RemoveChannelMember(ctx context.Context, channelID string, memberID string) (*http.Response, error) {
+	res, err := c.client.request(ctx, http.MethodDelete, chatChannelPath(channelID)+"/members/"+url.QueryEscape(memberID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// ChatMessageOptions selects the channel or contact a message was sent to. Exactly one of them must be set.
+type ChatMessageOptions struct {
+	ToChannel *string `url:"to_channel,omitempty"`
+	ToContact *string `url:"to_contact,omitempty"`
+}
+
+type ChatListMessagesOptions struct {
+	*PaginationOptions `url:",omitempty"`
+	ChatMessageOptions
+
+	// Date lists the messages sent on a day. It is ignored when From is set.
+	Date                           *time.Time `url:"date,omitempty" layout:"2006-01-02"`
+	From                           *time.Time `url:"from,omitempty"`
+	IncludeDeletedAndEditedMessage *bool      `url:"include_deleted_and_edited_message,omitempty"`
+	SearchKey                      *string    `url:"search_key,omitempty"`
+	SearchType                     *string    `url:"search_type,omitempty"`
+	To                             *time.Time `url:"to,omitempty"`
+}
+
+type ChatListMessagesResponse struct {
+	*PaginationResponse
+
+	Date     string         `json:"date"`
+	From     string         `json:"from"`
+	Messages []*ChatMessage `json:"messages"`
+	To       string         `json:"to"`
+}
+
+type ChatMessage struct {
+	AtItems                   []*ChatAtItem      `json:"at_items"`
+	DateTime                  time.Time          `json:"date_time"`
+	Files                     []*ChatMessageFile `json:"files"`
+	ID                        string             `json:"id"`
+	Message                   string             `json:"message"`
+	Reactions                 []*ChatReaction    `json:"reactions"`
+	ReplyMainMessageID        string             `json:"reply_main_message_id"`
+	ReplyMainMessageTimestamp int64              `json:"reply_main_message_timestamp"`
+	RichText                  []*ChatRichText    `json:"rich_text"`
+	Sender                    string             `json:"sender"`
+	SenderDisplayName         string             `json:"sender_display_name"`
+	Status                    string             `json:"status"`
+	// Timestamp is when the message was sent in milliseconds since the Unix epoch.
+	Timestamp int64 `json:"timestamp"`
+}
+
+type ChatMessageFile struct {
+	DownloadURL string `json:"download_url"`
+	FileID      string `json:"file_id"`
+	FileName    string `json:"file_name"`
+	FileSize    int64  `json:"file_size"`
+}
+
+type ChatReaction struct {
+	Emoji      string   `json:"emoji"`
+	Senders    []string `json:"senders"`
+	TotalCount int      `json:"total_count"`
+}
+
+// ChatRichText styles the characters of a message from StartPosition to EndPosition.
+type ChatRichText struct {
+	EndPosition   int            `json:"end_position"`
+	FormatAttr    string         `json:"format_attr,omitempty"`
+	FormatType    ChatFormatType `json:"format_type"`
+	StartPosition int            `json:"start_position"`
+}
+
+// ChatAtItem mentions a contact, or everyone, in the characters of a message from StartPosition to EndPosition.
+type ChatAtItem struct {
+	AtContact     string     `json:"at_contact,omitempty"`
+	AtType        ChatAtType `json:"at_type"`
+	EndPosition   int        `json:"end_position"`
+	StartPosition int        `json:"start_position"`
+}
+
+// ListMessages lists a user's messages in a channel or with a contact, one page at a time.
+func (c *ChatService) ListMessages(ctx context.Context, userID string, opts *ChatListMessagesOptions) (*ChatListMessagesResponse, *http.Response, error) {
+	out := &ChatListMessagesResponse{}
+
+	res, err := c.client.request(ctx, http.MethodGet, chatMessagesPath(userID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListMessagesAll follows pagination to list every message matching opts.
+func (c *ChatService) ListMessagesAll(ctx context.Context, userID string, opts *ChatListMessagesOptions) ([]*ChatMessage, error) {
+	pageOpts := &ChatListMessagesOptions{}
+	if opts != nil {
+		*pageOpts = *opts
+	}
+
+	var messages []*ChatMessage
+
+	err := listAll(ctx, pageOpts.PaginationOptions, func(page *PaginationOptions) (*PaginationResponse, error) {
+		pageOpts.PaginationOptions = page
+
+		out, _, err := c.ListMessages(ctx, userID, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, out.Messages...)
+
+		return out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (c *ChatService) GetMessage(ctx context.Context, userID string, messageID string, opts *ChatMessageOptions) (*ChatMessage, *http.Response, error) {
+	out := &ChatMessage{}
+
+	res, err := c.client.request(ctx, http.MethodGet, chatMessagesPath(userID)+"/"+url.QueryEscape(messageID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ChatSendMessageOptions struct {
+	AtItems []*ChatAtItem `json:"at_items,omitempty"`
+	// FileIDs attaches files uploaded with UploadFile.
+	FileIDs            []string        `json:"file_ids,omitempty"`
+	Message            string          `json:"message"`
+	ReplyMainMessageID *string         `json:"reply_main_message_id,omitempty"`
+	RichText           []*ChatRichText `json:"rich_text,omitempty"`
+	ToChannel          *string         `json:"to_channel,omitempty"`
+	ToContact          *string         `json:"to_contact,omitempty"`
+}
+
+type ChatSendMessageResponse struct {
+	ID string `json:"id"`
+}
+
+// SendMessage sends a message as a user to a channel or contact, or as a reply when ReplyMainMessageID is set.
+func (c *ChatService) SendMessage(ctx context.Context, userID string, opts *ChatSendMessageOptions) (*ChatSendMessageResponse, *http.Response, error) {
+	out := &ChatSendMessageResponse{}
+
+	res, err := c.client.request(ctx, http.MethodPost, chatMessagesPath(userID), nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ChatUpdateMessageOptions struct {
+	AtItems   []*ChatAtItem   `json:"at_items,omitempty"`
+	FileIDs   []string        `json:"file_ids,omitempty"`
+	Message   string          `json:"message"`
+	RichText  []*ChatRichText `json:"rich_text,omitempty"`
+	ToChannel *string         `json:"to_channel,omitempty"`
+	ToContact *string         `json:"to_contact,omitempty"`
+}
+
+// UpdateMessage replaces the text of a message the user sent.
+func (c *ChatService) UpdateMessage(ctx context.Context, userID string, messageID string, opts *ChatUpdateMessageOptions) (*http.Response, error) {
+	res, err := c.client.request(ctx, http.MethodPut, chatMessagesPath(userID)+"/"+url.QueryEscape(messageID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (c *ChatService) DeleteMessage(ctx context.Context, userID string, messageID string, opts *ChatMessageOptions) (*http.Response, error) {
+	res, err := c.client.request(ctx, http.MethodDelete, chatMessagesPath(userID)+"/"+url.QueryEscape(messageID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type ChatReactOptions struct {
+	Action ChatReactionAction `json:"action"`
+	// Emoji is the emoji itself or its Unicode code point, such as U+1F600.
+	Emoji     string  `json:"emoji"`
+	ToChannel *string `json:"to_channel,omitempty"`
+	ToContact *string `json:"to_contact,omitempty"`
+}
+
+// React adds or removes the user's emoji reaction to a message.
+func (c *ChatService) React(ctx context.Context, userID string, messageID string, opts *ChatReactOptions) (*http.Response, error) {
+	res, err := c.client.request(ctx, http.MethodPatch, chatMessagesPath(userID)+"/"+url.QueryEscape(messageID)+"/emoji_reactions", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type ChatUploadFileResponse struct {
+	ID string `json:"id"`
+}
+
+// UploadFile uploads a file to a user's chat files, to be attached to messages by ID with SendMessage.
+func (c *ChatService) UploadFile(ctx context.Context, userID string, fileName string, r io.Reader) (*ChatUploadFileResponse, *http.Response, error) {
+	out := &ChatUploadFileResponse{}
+
+	res, err := c.client.upload(ctx, fileBaseURL+"/chat/users/"+url.QueryEscape(userID)+"/files", "file", fileName, r, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("uploading file: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ChatSendFileOptions struct {
+	ReplyMainMessageID *string
+	ToChannel          *string
+	ToContact          *string
+}
+
+// SendFile uploads a file and sends it as a message from a user to a channel or contact in one request.
+func (c *ChatService) SendFile(ctx context.Context, userID string, fileName string, r io.Reader, opts *ChatSendFileOptions) (*ChatSendMessageResponse, *http.Response, error) {
+	fields := map[string]string{}
+	if opts != nil {
+		for name, value := range map[string]*string{
+			"reply_main_message_id": opts.ReplyMainMessageID,
+			"to_channel":            opts.ToChannel,
+			"to_contact":            opts.ToContact,
+		} {
+			if value != nil {
+				fields[name] = *value
+			}
+		}
+	}
+
+	out := &ChatSendMessageResponse{}
+
+	res, err := c.client.upload(ctx, fileBaseURL+chatMessagesPath(userID)+"/files", "files", fileName, r, fields, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("uploading file: %w", err)
+	}
+
+	return out, res, nil
+}
@@ -0,0 +1,71 @@
+// Package chatbot sends Team Chat chatbot messages and handles the slash commands and interactive events Zoom sends to
+// a chatbot's endpoint.
+package chatbot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+// Client sends messages as a chatbot. It is authenticated with the client credentials grant of the chatbot's app, so
+// its token mutex must not be shared with a zoom.Client.
+type Client struct {
+	client *zoom.Client
+}
+
+func NewClient(httpClient *http.Client, clientID, clientSecret string, tokenMutex zoom.TokenMutex) *Client {
+	return &Client{
+		client: zoom.NewClientCredentialsClient(httpClient, clientID, clientSecret, tokenMutex),
+	}
+}
+
+type MessageResponse struct {
+	MessageID string `json:"message_id"`
+	RobotJID  string `json:"robot_jid"`
+	SentTime  string `json:"sent_time"`
+	ToJID     string `json:"to_jid"`
+}
+
+func (c *Client) SendMessage(ctx context.Context, m *Message) (*MessageResponse, *http.Response, error) {
+	out := &MessageResponse{}
+
+	res, err := c.client.Do(ctx, http.MethodPost, "/im/chat/messages", nil, m, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// EditMessage replaces the content of a message the chatbot sent, such as to update a card after one of its buttons
+// is clicked.
+func (c *Client) EditMessage(ctx context.Context, messageID string, m *Message) (*MessageResponse, *http.Response, error) {
+	out := &MessageResponse{}
+
+	res, err := c.client.Do(ctx, http.MethodPut, "/im/chat/messages/"+url.PathEscape(messageID), nil, m, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// DeleteMessageOptions identifies the chatbot deleting the message. AccountID and RobotJID are required.
+type DeleteMessageOptions struct {
+	AccountID string `url:"account_id"`
+	RobotJID  string `url:"robot_jid"`
+	UserJID   string `url:"user_jid,omitempty"`
+}
+
+func (c *Client) DeleteMessage(ctx context.Context, messageID string, opts *DeleteMessageOptions) (*http.Response, error) {
+	res, err := c.client.Do(ctx, http.MethodDelete, "/im/chat/messages/"+url.PathEscape(messageID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,133 @@
+package chatbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *int) {
+	tokens := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		tokens++
+
+		id, secret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "id", id)
+		assert.Equal(t, "secret", secret)
+		assert.Equal(t, "client_credentials", r.URL.Query().Get("grant_type"))
+
+		w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		handler(w, r)
+	})
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	target, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewClient(&http.Client{Transport: &rewriteTransport{target: target}}, "id", "secret", nil), &tokens
+}
+
+// rewriteTransport sends requests to the test server instead of Zoom.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (r *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_SendMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	c, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST /v2/im/chat/messages", r.Method+" "+r.URL.Path)
+
+		m := &Message{}
+		assert.NoError(json.NewDecoder(r.Body).Decode(m))
+		assert.Equal("robot@xmpp.zoom.us", m.RobotJID)
+		assert.Equal("Hello", m.Content.Head.Text)
+
+		w.Write([]byte(`{"message_id":"m1","robot_jid":"robot@xmpp.zoom.us","to_jid":"channel@xmpp.zoom.us"}`))
+	})
+
+	for range 2 {
+		out, _, err := c.SendMessage(context.Background(), &Message{
+			AccountID: "account",
+			RobotJID:  "robot@xmpp.zoom.us",
+			ToJID:     "channel@xmpp.zoom.us",
+			Content:   NewCard("Hello").Content(),
+		})
+
+		assert.NoError(err)
+		assert.Equal("m1", out.MessageID)
+	}
+
+	assert.Equal(1, *tokens)
+}
+
+func TestClient_DeleteMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("DELETE /v2/im/chat/messages/m1", r.Method+" "+r.URL.Path)
+		assert.Equal("account_id=account&robot_jid=robot%40xmpp.zoom.us", r.URL.RawQuery)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := c.DeleteMessage(context.Background(), "m1", &DeleteMessageOptions{
+		AccountID: "account",
+		RobotJID:  "robot@xmpp.zoom.us",
+	})
+
+	assert.NoError(err)
+}
+
+func TestClient_DeleteMessage_NilOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(r.URL.RawQuery)
+
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":300,"message":"Robot JID is required."}`))
+	})
+
+	_, err := c.DeleteMessage(context.Background(), "m1", nil)
+	assert.ErrorContains(err, "Robot JID is required.")
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	assert := assert.New(t)
+
+	c, tokens := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":124,"message":"Invalid access token."}`))
+	})
+
+	_, _, err := c.EditMessage(context.Background(), "m1", &Message{})
+	assert.ErrorContains(err, "Invalid access token.")
+
+	_, _, err = c.EditMessage(context.Background(), "m1", &Message{})
+	assert.Error(err)
+	assert.Equal(2, *tokens)
+}
@@ -0,0 +1,71 @@
+package chatbot
+
+import "encoding/json"
+
+const (
+	// EventBotNotification is sent when a user runs the chatbot's slash command or messages it directly.
+	EventBotNotification = "bot_notification"
+	EventBotInstalled    = "bot_installed"
+	EventAppDeauthorized = "app_deauthorized"
+
+	// EventInteractiveMessageActions is sent when a button of an ActionsBlock is clicked.
+	EventInteractiveMessageActions = "interactive_message_actions"
+	// EventInteractiveMessageSelect is sent when an item of a SelectBlock is chosen.
+	EventInteractiveMessageSelect = "interactive_message_select"
+	// EventInteractiveMessageFieldsEditable is sent when an editable Field is changed.
+	EventInteractiveMessageFieldsEditable = "interactive_message_fields_editable"
+	// EventInteractiveMessageEditable is sent when the text of an editable message is changed.
+	EventInteractiveMessageEditable = "interactive_message_editable"
+
+	eventURLValidation = "endpoint.url_validation"
+)
+
+// Event is a notification Zoom sends to the chatbot's endpoint.
+type Event struct {
+	Event string `json:"event"`
+	// EventTS is when the event happened in milliseconds since the Unix epoch.
+	EventTS int64           `json:"event_ts"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Command is the payload of a bot_notification event.
+type Command struct {
+	AccountID   string `json:"accountId"`
+	ChannelName string `json:"channelName"`
+	// Cmd is the text the user sent after the slash command.
+	Cmd       string `json:"cmd"`
+	Name      string `json:"name"`
+	RobotJID  string `json:"robotJid"`
+	Timestamp int64  `json:"timestamp"`
+	// ToJID is where to send the reply, the channel or the user's direct messages with the chatbot.
+	ToJID     string `json:"toJid"`
+	TriggerID string `json:"triggerId"`
+	UserID    string `json:"userId"`
+	UserJID   string `json:"userJid"`
+	UserName  string `json:"userName"`
+}
+
+// Action is the payload of an interactive_message_* event. Which of ActionItem, SelectedItems and FieldEditItem is
+// set depends on the event.
+type Action struct {
+	AccountID     string         `json:"accountId"`
+	ActionItem    *SelectItem    `json:"actionItem"`
+	ChannelName   string         `json:"channelName"`
+	FieldEditItem *FieldEditItem `json:"fieldEditItem"`
+	MessageID     string         `json:"messageId"`
+	// Original is the content of the message the action was taken on.
+	Original      json.RawMessage `json:"original"`
+	RobotJID      string          `json:"robotJid"`
+	SelectedItems []*SelectItem   `json:"selectedItems"`
+	Timestamp     int64           `json:"timestamp"`
+	ToJID         string          `json:"toJid"`
+	UserID        string          `json:"userId"`
+	UserJID       string          `json:"userJid"`
+	UserName      string          `json:"userName"`
+}
+
+type FieldEditItem struct {
+	CurrentValue string `json:"currentValue"`
+	Key          string `json:"key"`
+	NewValue     string `json:"newValue"`
+}
@@ -0,0 +1,168 @@
+package chatbot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxBodySize is the largest notification the handler reads.
+	maxBodySize = 1 << 20
+	// maxSkew is how old a notification's timestamp may be before it is rejected as a replay.
+	maxSkew = 5 * time.Minute
+)
+
+var ErrInvalidSignature = errors.New("invalid x-zm-signature")
+var ErrStaleRequest = errors.New("x-zm-request-timestamp is too old")
+
+// Handler verifies the notifications Zoom sends to the chatbot's endpoint and dispatches them to its callbacks. It
+// answers Zoom's endpoint URL validation itself. A callback returning an error makes the handler respond with a 500,
+// so Zoom retries the notification.
+type Handler struct {
+	secretToken string
+	now         func() time.Time
+
+	// OnCommand handles bot_notification events.
+	OnCommand func(ctx context.Context, cmd *Command) error
+	// OnAction handles interactive_message_* events, which are named by event.
+	OnAction func(ctx context.Context, event string, action *Action) error
+	// OnEvent handles the events without a more specific callback set.
+	OnEvent func(ctx context.Context, event *Event) error
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler returns a handler verifying notifications with the secret token of the chatbot's app.
+func NewHandler(secretToken string) *Handler {
+	return &Handler{
+		secretToken: secretToken,
+		now:         time.Now,
+	}
+}
+
+// Verify checks that body was signed by Zoom with secretToken in the x-zm-signature header within the last 5 minutes.
+func Verify(secretToken string, header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get("x-zm-request-timestamp")
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if now.Sub(time.Unix(seconds, 0)).Abs() > maxSkew {
+		return ErrStaleRequest
+	}
+
+	signature, ok := strings.CutPrefix(header.Get("x-zm-signature"), "v0=")
+	if !ok {
+		return ErrInvalidSignature
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(got, sign(secretToken, "v0:"+timestamp+":"+string(body))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func sign(secretToken, message string) []byte {
+	mac := hmac.New(sha256.New, []byte(secretToken))
+	mac.Write([]byte(message))
+
+	return mac.Sum(nil)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+
+	err = Verify(h.secretToken, r.Header, body, h.now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	event := &Event{}
+	err = json.Unmarshal(body, event)
+	if err != nil {
+		http.Error(w, "decoding body", http.StatusBadRequest)
+		return
+	}
+
+	if event.Event == eventURLValidation {
+		h.validateURL(w, event)
+		return
+	}
+
+	err = h.dispatch(r.Context(), event)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) dispatch(ctx context.Context, event *Event) error {
+	switch {
+	case event.Event == EventBotNotification && h.OnCommand != nil:
+		cmd := &Command{}
+		err := json.Unmarshal(event.Payload, cmd)
+		if err != nil {
+			return err
+		}
+
+		return h.OnCommand(ctx, cmd)
+	case strings.HasPrefix(event.Event, "interactive_message_") && h.OnAction != nil:
+		action := &Action{}
+		err := json.Unmarshal(event.Payload, action)
+		if err != nil {
+			return err
+		}
+
+		return h.OnAction(ctx, event.Event, action)
+	case h.OnEvent != nil:
+		return h.OnEvent(ctx, event)
+	}
+
+	return nil
+}
+
+type urlValidation struct {
+	EncryptedToken string `json:"encryptedToken,omitempty"`
+	PlainToken     string `json:"plainToken"`
+}
+
+// validateURL answers the challenge Zoom sends when the endpoint URL is saved and periodically after.
+func (h *Handler) validateURL(w http.ResponseWriter, event *Event) {
+	validation := &urlValidation{}
+	err := json.Unmarshal(event.Payload, validation)
+	if err != nil || len(validation.PlainToken) == 0 {
+		http.Error(w, "missing plainToken", http.StatusBadRequest)
+		return
+	}
+
+	validation.EncryptedToken = hex.EncodeToString(sign(h.secretToken, validation.PlainToken))
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(validation)
+}
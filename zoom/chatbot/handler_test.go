@@ -0,0 +1,125 @@
+package chatbot
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2024, 1, 8, 9, 30, 0, 0, time.UTC)
+
+func newSignedRequest(secretToken string, timestamp time.Time, body string) *http.Request {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("x-zm-request-timestamp", ts)
+	r.Header.Set("x-zm-signature", "v0="+hex.EncodeToString(sign(secretToken, "v0:"+ts+":"+body)))
+
+	return r
+}
+
+func newTestHandler() *Handler {
+	h := NewHandler("secret")
+	h.now = func() time.Time {
+		return testNow
+	}
+
+	return h
+}
+
+func TestVerify(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `{"event":"bot_installed"}`
+
+	r := newSignedRequest("secret", testNow, body)
+	assert.NoError(Verify("secret", r.Header, []byte(body), testNow))
+	assert.ErrorIs(Verify("other", r.Header, []byte(body), testNow), ErrInvalidSignature)
+	assert.ErrorIs(Verify("secret", r.Header, []byte(body+" "), testNow), ErrInvalidSignature)
+
+	r = newSignedRequest("secret", testNow.Add(-10*time.Minute), body)
+	assert.ErrorIs(Verify("secret", r.Header, []byte(body), testNow), ErrStaleRequest)
+
+	r.Header.Del("x-zm-request-timestamp")
+	assert.ErrorIs(Verify("secret", r.Header, []byte(body), testNow), ErrInvalidSignature)
+}
+
+func TestHandler_URLValidation(t *testing.T) {
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	newTestHandler().ServeHTTP(w, newSignedRequest("secret", testNow, `{"event":"endpoint.url_validation","payload":{"plainToken":"abc"}}`))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.JSONEq(`{"plainToken":"abc","encryptedToken":"`+hex.EncodeToString(sign("secret", "abc"))+`"}`, w.Body.String())
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+	assert := assert.New(t)
+
+	var cmd *Command
+	var actionEvent string
+	var action *Action
+	var event *Event
+
+	h := newTestHandler()
+	h.OnCommand = func(ctx context.Context, c *Command) error {
+		cmd = c
+		return nil
+	}
+	h.OnAction = func(ctx context.Context, e string, a *Action) error {
+		actionEvent, action = e, a
+		return nil
+	}
+	h.OnEvent = func(ctx context.Context, e *Event) error {
+		event = e
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest("secret", testNow, `{"event":"bot_notification","payload":{"cmd":"deploy api","toJid":"channel@xmpp.zoom.us","userName":"Jane"}}`))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(&Command{Cmd: "deploy api", ToJID: "channel@xmpp.zoom.us", UserName: "Jane"}, cmd)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest("secret", testNow, `{"event":"interactive_message_actions","payload":{"messageId":"m1","actionItem":{"text":"Roll back","value":"rollback"}}}`))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(EventInteractiveMessageActions, actionEvent)
+	assert.Equal("m1", action.MessageID)
+	assert.Equal("rollback", action.ActionItem.Value)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest("secret", testNow, `{"event":"bot_installed","event_ts":1704706200000}`))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Equal(EventBotInstalled, event.Event)
+	assert.Equal(int64(1704706200000), event.EventTS)
+}
+
+func TestHandler_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	h := newTestHandler()
+	h.OnCommand = func(ctx context.Context, c *Command) error {
+		return errors.New("failed")
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest("secret", testNow, `{"event":"bot_notification","payload":{}}`))
+	assert.Equal(http.StatusInternalServerError, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newSignedRequest("other", testNow, `{"event":"bot_notification","payload":{}}`))
+	assert.Equal(http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(http.StatusMethodNotAllowed, w.Code)
+}
@@ -0,0 +1,293 @@
+package chatbot
+
+import "encoding/json"
+
+const (
+	ButtonStyleDefault  ButtonStyle = "Default"
+	ButtonStylePrimary  ButtonStyle = "Primary"
+	ButtonStyleDanger   ButtonStyle = "Danger"
+	ButtonStyleDisabled ButtonStyle = "Disabled"
+)
+
+type ButtonStyle string
+
+func (b ButtonStyle) String() string {
+	return string(b)
+}
+
+// Message is a chatbot message sent to a channel or user. It is also the body of a message edit, which ignores
+// ToJID, VisibleToUser and ReplyMainMessageID.
+type Message struct {
+	AccountID         string   `json:"account_id"`
+	Content           *Content `json:"content"`
+	IsMarkdownSupport bool     `json:"is_markdown_support,omitempty"`
+	// ReplyMainMessageID sends the message as a reply in the thread of another message.
+	ReplyMainMessageID string `json:"reply_main_message_id,omitempty"`
+	RobotJID           string `json:"robot_jid"`
+	// ToJID is the JID of the channel or user the message is sent to, the ToJID of the command being answered.
+	ToJID string `json:"to_jid,omitempty"`
+	// UserJID is the JID of the user who authorized the bot. It is required when messaging a channel the bot is not
+	// a member of.
+	UserJID string `json:"user_jid,omitempty"`
+	// VisibleToUser makes a message sent to a channel visible only to the user with this JID.
+	VisibleToUser string `json:"visible_to_user,omitempty"`
+}
+
+// Content is the card a message renders as, a header followed by blocks. Build it with NewCard.
+type Content struct {
+	Body []Block `json:"body,omitempty"`
+	Head *Head   `json:"head,omitempty"`
+}
+
+type Head struct {
+	Style   *Style   `json:"style,omitempty"`
+	SubHead *SubHead `json:"sub_head,omitempty"`
+	Text    string   `json:"text"`
+}
+
+type SubHead struct {
+	Style *Style `json:"style,omitempty"`
+	Text  string `json:"text"`
+}
+
+type Style struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	// Color is a hex color such as #FF0000.
+	Color string `json:"color,omitempty"`
+}
+
+// Block is one element of a card's body. Its JSON includes the type Zoom renders it as.
+type Block interface {
+	blockType() string
+}
+
+// MessageBlock is a paragraph of text, linked to Link when it is set.
+type MessageBlock struct {
+	Link  string `json:"link,omitempty"`
+	Style *Style `json:"style,omitempty"`
+	Text  string `json:"text"`
+}
+
+// SectionBlock groups blocks next to a colored sidebar.
+type SectionBlock struct {
+	Footer       string  `json:"footer,omitempty"`
+	FooterIcon   string  `json:"footer_icon,omitempty"`
+	Sections     []Block `json:"sections"`
+	SidebarColor string  `json:"sidebar_color,omitempty"`
+	// TS is the time shown in the footer in milliseconds since the Unix epoch.
+	TS int64 `json:"ts,omitempty"`
+}
+
+// ActionsBlock is a row of buttons. Clicking one sends an interactive_message_actions event with its value.
+type ActionsBlock struct {
+	Items []*Button `json:"items"`
+	// Limit is how many buttons are shown before the rest are collapsed into a menu.
+	Limit int `json:"limit,omitempty"`
+}
+
+type Button struct {
+	Style ButtonStyle `json:"style,omitempty"`
+	Text  string      `json:"text"`
+	Value string      `json:"value"`
+}
+
+// FieldsBlock is a list of key value pairs. Changing an editable one sends an interactive_message_fields_editable
+// event.
+type FieldsBlock struct {
+	Items []*Field `json:"items"`
+}
+
+type Field struct {
+	Editable bool   `json:"editable,omitempty"`
+	Key      string `json:"key"`
+	// Short fields are shown two to a row.
+	Short bool   `json:"short,omitempty"`
+	Value string `json:"value"`
+}
+
+// SelectBlock is a dropdown. Choosing an item sends an interactive_message_select event with its value.
+type SelectBlock struct {
+	SelectItems  []*SelectItem `json:"select_items"`
+	SelectedItem *SelectItem   `json:"selected_item,omitempty"`
+	Text         string        `json:"text"`
+}
+
+type SelectItem struct {
+	Text  string `json:"text"`
+	Value string `json:"value"`
+}
+
+// FormFieldBlock is a form of text inputs.
+type FormFieldBlock struct {
+	Items []*FormField `json:"items"`
+}
+
+type FormField struct {
+	Editable    bool   `json:"editable"`
+	Key         string `json:"key"`
+	Placeholder string `json:"placeholder,omitempty"`
+	Value       string `json:"value"`
+}
+
+// AttachmentsBlock is a link to a file or page, shown with an image.
+type AttachmentsBlock struct {
+	Ext         string       `json:"ext,omitempty"`
+	ImgURL      string       `json:"img_url,omitempty"`
+	Information *Information `json:"information,omitempty"`
+	ResourceURL string       `json:"resource_url"`
+	Size        int64        `json:"size,omitempty"`
+}
+
+type Information struct {
+	Description *SubHead `json:"description,omitempty"`
+	Title       *SubHead `json:"title,omitempty"`
+}
+
+// DividerBlock is a horizontal line.
+type DividerBlock struct{}
+
+func (*MessageBlock) blockType() string     { return "message" }
+func (*SectionBlock) blockType() string     { return "section" }
+func (*ActionsBlock) blockType() string     { return "actions" }
+func (*FieldsBlock) blockType() string      { return "fields" }
+func (*SelectBlock) blockType() string      { return "select" }
+func (*FormFieldBlock) blockType() string   { return "form_field" }
+func (*AttachmentsBlock) blockType() string { return "attachments" }
+func (*DividerBlock) blockType() string     { return "divider" }
+
+// typed adds the type of b to the JSON object of v, an alias of b's type without its MarshalJSON method.
+func typed(b Block, v any) ([]byte, error) {
+	fields, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := json.Marshal(b.blockType())
+	if err != nil {
+		return nil, err
+	}
+
+	out := append([]byte(`{"type":`), typ...)
+	if len(fields) > len("{}") {
+		out = append(out, ',')
+	}
+
+	return append(out, fields[1:]...), nil
+}
+
+func (b *MessageBlock) MarshalJSON() ([]byte, error) {
+	type alias MessageBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *SectionBlock) MarshalJSON() ([]byte, error) {
+	type alias SectionBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *ActionsBlock) MarshalJSON() ([]byte, error) {
+	type alias ActionsBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *FieldsBlock) MarshalJSON() ([]byte, error) {
+	type alias FieldsBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *SelectBlock) MarshalJSON() ([]byte, error) {
+	type alias SelectBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *FormFieldBlock) MarshalJSON() ([]byte, error) {
+	type alias FormFieldBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *AttachmentsBlock) MarshalJSON() ([]byte, error) {
+	type alias AttachmentsBlock
+	return typed(b, (*alias)(b))
+}
+
+func (b *DividerBlock) MarshalJSON() ([]byte, error) {
+	type alias DividerBlock
+	return typed(b, (*alias)(b))
+}
+
+// Card builds a message's content one block at a time.
+type Card struct {
+	content *Content
+}
+
+// NewCard starts a card with a header.
+func NewCard(head string) *Card {
+	return &Card{
+		content: &Content{
+			Head: &Head{Text: head},
+		},
+	}
+}
+
+// SubHead sets the text shown under the header.
+func (c *Card) SubHead(text string) *Card {
+	if c.content.Head == nil {
+		c.content.Head = &Head{}
+	}
+
+	c.content.Head.SubHead = &SubHead{Text: text}
+
+	return c
+}
+
+// Add appends blocks to the card's body.
+func (c *Card) Add(blocks ...Block) *Card {
+	c.content.Body = append(c.content.Body, blocks...)
+	return c
+}
+
+func (c *Card) Text(text string) *Card {
+	return c.Add(&MessageBlock{Text: text})
+}
+
+func (c *Card) StyledText(text string, style *Style) *Card {
+	return c.Add(&MessageBlock{Text: text, Style: style})
+}
+
+func (c *Card) Link(text, link string) *Card {
+	return c.Add(&MessageBlock{Text: text, Link: link})
+}
+
+func (c *Card) Fields(fields ...*Field) *Card {
+	return c.Add(&FieldsBlock{Items: fields})
+}
+
+func (c *Card) Buttons(buttons ...*Button) *Card {
+	return c.Add(&ActionsBlock{Items: buttons})
+}
+
+func (c *Card) Select(text string, items ...*SelectItem) *Card {
+	return c.Add(&SelectBlock{Text: text, SelectItems: items})
+}
+
+func (c *Card) Form(fields ...*FormField) *Card {
+	return c.Add(&FormFieldBlock{Items: fields})
+}
+
+func (c *Card) Divider() *Card {
+	return c.Add(&DividerBlock{})
+}
+
+// Section adds a section with a sidebar of color, whose blocks are added by build to the card it is given.
+func (c *Card) Section(color string, build func(*Card)) *Card {
+	section := &Card{content: &Content{}}
+	build(section)
+
+	return c.Add(&SectionBlock{SidebarColor: color, Sections: section.content.Body})
+}
+
+// Content returns the card's content to send in a Message.
+func (c *Card) Content() *Content {
+	return c.content
+}
@@ -0,0 +1,51 @@
+package chatbot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCard(t *testing.T) {
+	assert := assert.New(t)
+
+	content := NewCard("Deploy finished").
+		SubHead("api v1.2.0").
+		Text("All checks passed.").
+		Section("#2D8CFF", func(c *Card) {
+			c.Fields(&Field{Key: "Environment", Value: "production", Short: true})
+			c.Link("Build log", "https://ci.example.com/builds/1")
+		}).
+		Divider().
+		Select("Notify", &SelectItem{Text: "Team", Value: "team"}).
+		Form(&FormField{Key: "Reason", Editable: true, Placeholder: "Why roll back?"}).
+		Buttons(&Button{Text: "Roll back", Value: "rollback", Style: ButtonStyleDanger}).
+		Content()
+
+	b, err := json.Marshal(content)
+	assert.NoError(err)
+	assert.JSONEq(`{
+		"head": {"text": "Deploy finished", "sub_head": {"text": "api v1.2.0"}},
+		"body": [
+			{"type": "message", "text": "All checks passed."},
+			{"type": "section", "sidebar_color": "#2D8CFF", "sections": [
+				{"type": "fields", "items": [{"key": "Environment", "value": "production", "short": true}]},
+				{"type": "message", "text": "Build log", "link": "https://ci.example.com/builds/1"}
+			]},
+			{"type": "divider"},
+			{"type": "select", "text": "Notify", "select_items": [{"text": "Team", "value": "team"}]},
+			{"type": "form_field", "items": [{"key": "Reason", "value": "", "editable": true, "placeholder": "Why roll back?"}]},
+			{"type": "actions", "items": [{"text": "Roll back", "value": "rollback", "style": "Danger"}]}
+		]
+	}`, string(b))
+}
+
+func TestCard_SubHeadWithoutHead(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &Card{content: &Content{}}
+	c.SubHead("sub")
+
+	assert.Equal("sub", c.Content().Head.SubHead.Text)
+}
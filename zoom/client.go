@@ -12,13 +12,15 @@ import (
 	"time"
 
 	"github.com/fterrag/go-zoom/zoom/tokenmutex"
-	"github.com/golang-jwt/jwt/v4"
 	querystring "github.com/google/go-querystring/query"
 )
 
 const (
 	authURL = "https://zoom.us/oauth/token"
 	baseURL = "https://api.zoom.us/v2"
+
+	grantTypeAccountCredentials = "account_credentials"
+	grantTypeClientCredentials  = "client_credentials"
 )
 
 type Client struct {
@@ -27,9 +29,27 @@ type Client struct {
 	clientID     string
 	clientSecret string
 	tokenMutex   TokenMutex
-
-	Users    *UsersService
-	Meetings *MeetingsService
+	grantType    string
+	// auth returns the bearer token requests are authenticated with.
+	auth func(context.Context) (string, error)
+
+	Users       *UsersService
+	Meetings    *MeetingsService
+	Recordings  *RecordingsService
+	Webinars    *WebinarsService
+	Reports     *ReportsService
+	Dashboard   *DashboardService
+	Groups      *GroupsService
+	Roles       *RolesService
+	Accounts    *AccountsService
+	Chat        *ChatService
+	Phone       *PhoneService
+	Rooms       *RoomsService
+	H323Devices *H323DevicesService
+	SIPPhones   *SIPPhonesService
+
+	// VideoSDK is only set on clients created with NewVideoSDKClient, which have none of the other services.
+	VideoSDK *VideoSDKService
 }
 
 type PaginationOptions struct {
@@ -64,10 +84,45 @@ func NewClient(httpClient *http.Client, accountID, clientID, clientSecret string
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		tokenMutex:   tokenMutex,
+		grantType:    grantTypeAccountCredentials,
 	}
 
+	c.auth = c.token
+
 	c.Users = &UsersService{c}
 	c.Meetings = &MeetingsService{c}
+	c.Recordings = &RecordingsService{c}
+	c.Webinars = &WebinarsService{c}
+	c.Reports = &ReportsService{c}
+	c.Dashboard = &DashboardService{c}
+	c.Groups = &GroupsService{c}
+	c.Roles = &RolesService{c}
+	c.Accounts = &AccountsService{c}
+	c.Chat = &ChatService{c}
+	c.Phone = &PhoneService{c}
+	c.Rooms = &RoomsService{c}
+	c.H323Devices = &H323DevicesService{c}
+	c.SIPPhones = &SIPPhonesService{c}
+
+	return c
+}
+
+// NewClientCredentialsClient returns a client authenticated with the client credentials grant of an app, such as a
+// chatbot's. It has none of the services, so its requests are made with Do.
+func NewClientCredentialsClient(httpClient *http.Client, clientID, clientSecret string, tokenMutex TokenMutex) *Client {
+	if tokenMutex == nil {
+		tokenMutex = tokenmutex.NewDefault()
+	}
+
+	c := &Client{
+		httpClient:   httpClient,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		tokenMutex:   tokenMutex,
+		grantType:    grantTypeClientCredentials,
+	}
+
+	c.auth = c.token
 
 	return c
 }
@@ -88,47 +143,9 @@ type FieldError struct {
 }
 
 func (c *Client) request(ctx context.Context, method string, path string, query any, body any, out any) (*http.Response, error) {
-	err := c.tokenMutex.Lock(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("locking token mutex: %w", err)
-	}
-
-	token, err := c.tokenMutex.Get(ctx)
-	if err != nil {
-		if !errors.Is(err, tokenmutex.ErrTokenNotExist) && !errors.Is(err, tokenmutex.ErrTokenExpired) {
-			err = c.tokenMutex.Unlock(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("unlocking token mutex: %w", err)
-			}
-
-			return nil, fmt.Errorf("getting token mutex: %w", err)
-		}
-
-		var expiresAt time.Time
-		token, expiresAt, err = c.accessToken(ctx)
-		if err != nil {
-			err = c.tokenMutex.Unlock(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("unlocking token mutex: %w", err)
-			}
-
-			return nil, fmt.Errorf("requesting access token from Zoom: %w", err)
-		}
-
-		err = c.tokenMutex.Set(context.Background(), token, expiresAt)
-		if err != nil {
-			err = c.tokenMutex.Unlock(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("unlocking token mutex: %w", err)
-			}
-
-			return nil, fmt.Errorf("setting token mutex: %w", err)
-		}
-	}
-
-	err = c.tokenMutex.Unlock(ctx)
+	token, err := c.auth(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unlocking token mutex: %w", err)
+		return nil, err
 	}
 
 	q, err := querystring.Values(query)
@@ -164,31 +181,91 @@ func (c *Client) request(ctx context.Context, method string, path string, query
 		return nil, fmt.Errorf("doing HTTP request: %w", err)
 	}
 
+	return res, c.decodeResponse(ctx, res, out)
+}
+
+// Do makes a request to an endpoint without a service, such as the chatbot's. The path is relative to the API's base
+// URL, query is encoded with its url tags, body is sent as JSON and the response body is decoded into out.
+func (c *Client) Do(ctx context.Context, method string, path string, query any, body any, out any) (*http.Response, error) {
+	return c.request(ctx, method, path, query, body, out)
+}
+
+// decodeResponse decodes a successful response body into out, or returns the error Zoom responded with.
+func (c *Client) decodeResponse(ctx context.Context, res *http.Response, out any) error {
 	if res.StatusCode > http.StatusIMUsed {
-		if res.StatusCode == http.StatusUnauthorized {
-			err = c.tokenMutex.Clear(ctx)
+		if res.StatusCode == http.StatusUnauthorized && c.tokenMutex != nil {
+			err := c.tokenMutex.Clear(ctx)
 			if err != nil {
-				return res, fmt.Errorf("clearing token mutex when receiving a 401 from Zoom: %w", err)
+				return fmt.Errorf("clearing token mutex when receiving a 401 from Zoom: %w", err)
 			}
 		}
 
 		errRes := &ErrorResponse{}
-		err = json.NewDecoder(res.Body).Decode(errRes)
+		err := json.NewDecoder(res.Body).Decode(errRes)
 		if err != nil {
-			return res, fmt.Errorf("decoding response body: %w", err)
+			return fmt.Errorf("decoding response body: %w", err)
 		}
 
-		return res, fmt.Errorf("Zoom API error: %w", errRes)
+		return fmt.Errorf("Zoom API error: %w", errRes)
 	}
 
 	if out != nil {
-		err = json.NewDecoder(res.Body).Decode(out)
+		err := json.NewDecoder(res.Body).Decode(out)
+		if err != nil {
+			return fmt.Errorf("decoding response body: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// token returns the cached access token, requesting a new one from Zoom when it does not exist or has expired.
+func (c *Client) token(ctx context.Context) (string, error) {
+	err := c.tokenMutex.Lock(ctx)
+	if err != nil {
+		return "", fmt.Errorf("locking token mutex: %w", err)
+	}
+
+	token, err := c.tokenMutex.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, tokenmutex.ErrTokenNotExist) && !errors.Is(err, tokenmutex.ErrTokenExpired) {
+			unlockErr := c.tokenMutex.Unlock(ctx)
+			if unlockErr != nil {
+				return "", fmt.Errorf("unlocking token mutex: %w", unlockErr)
+			}
+
+			return "", fmt.Errorf("getting token mutex: %w", err)
+		}
+
+		var expiresAt time.Time
+		token, expiresAt, err = c.accessToken(ctx)
 		if err != nil {
-			return res, fmt.Errorf("decoding response body: %w", err)
+			unlockErr := c.tokenMutex.Unlock(ctx)
+			if unlockErr != nil {
+				return "", fmt.Errorf("unlocking token mutex: %w", unlockErr)
+			}
+
+			return "", fmt.Errorf("requesting access token from Zoom: %w", err)
 		}
+
+		// Add a buffer to the expiration.
+		err = c.tokenMutex.Set(context.Background(), token, expiresAt.Add(-5*time.Minute))
+		if err != nil {
+			unlockErr := c.tokenMutex.Unlock(ctx)
+			if unlockErr != nil {
+				return "", fmt.Errorf("unlocking token mutex: %w", unlockErr)
+			}
+
+			return "", fmt.Errorf("setting token mutex: %w", err)
+		}
+	}
+
+	err = c.tokenMutex.Unlock(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unlocking token mutex: %w", err)
 	}
 
-	return res, nil
+	return token, nil
 }
 
 type authResponse struct {
@@ -200,8 +277,10 @@ type authResponse struct {
 
 func (c *Client) accessToken(ctx context.Context) (string, time.Time, error) {
 	query := url.Values{}
-	query.Set("grant_type", "account_credentials")
-	query.Set("account_id", c.accountID)
+	query.Set("grant_type", c.grantType)
+	if c.grantType == grantTypeAccountCredentials {
+		query.Set("account_id", c.accountID)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", authURL+"?"+query.Encode(), nil)
 	if err != nil {
@@ -226,38 +305,16 @@ func (c *Client) accessToken(ctx context.Context) (string, time.Time, error) {
 		return "", time.Time{}, fmt.Errorf("decoding HTTP response body: %w", err)
 	}
 
-	// Add a buffer to the expiration.
-	expiresIn := authRes.ExpiresIn - 300
-
-	return authRes.AccessToken, time.Now().Add(time.Duration(expiresIn) * time.Second), nil
+	return authRes.AccessToken, time.Now().Add(time.Duration(authRes.ExpiresIn) * time.Second), nil
 }
 
-// MeetingSDKJWT creates a Meeting SDK JWT, signs it, and returns the signed string (see https://marketplace.zoom.us/docs/sdk/native-sdks/auth/#meeting-sdk-auth).
-// role is required for web, optional for native. 0 to specify participant or 1 to specify host.
-// expiration is the duration or expiration of JWT from now. Minimum duration is 1800 seconds, maximum duration is 48 hours. Default duration is 24 hours.
-func MeetingSDKJWT(meetingSDKKey, meetingSDKSecret string, meetingNumber int64, role int, expiration time.Duration) (string, error) {
-	if expiration == 0 {
-		expiration = 24 * time.Hour
-	}
-
-	now := time.Now().UTC()
-	exp := now.Add(expiration).Unix()
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"appKey":   meetingSDKKey,
-		"sdkKey":   meetingSDKKey,
-		"mn":       meetingNumber,
-		"role":     role,
-		"iat":      now.Unix(),
-		"exp":      exp,
-		"tokenExp": exp,
-	})
-
-	// Sign and get the complete encoded token as a string using the secret
-	tokenStr, err := token.SignedString([]byte(meetingSDKSecret))
+// AccessToken requests a new access token from Zoom, bypassing the token mutex, and returns it along with the time it
+// expires.
+func (c *Client) AccessToken(ctx context.Context) (string, time.Time, error) {
+	token, expiresAt, err := c.accessToken(ctx)
 	if err != nil {
-		return "", fmt.Errorf("signing JWT: %w", err)
+		return "", time.Time{}, fmt.Errorf("requesting access token from Zoom: %w", err)
 	}
 
-	return tokenStr, nil
+	return token, expiresAt, nil
 }
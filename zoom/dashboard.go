@@ -0,0 +1,430 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	DashboardTypeLive    = "live"
+	DashboardTypePast    = "past"
+	DashboardTypePastOne = "pastOne"
+)
+
+type DashboardServicer interface {
+	ListMeetings(ctx context.Context, opts *DashboardListOptions) (*DashboardListMeetingsResponse, *http.Response, error)
+	GetMeeting(ctx context.Context, meetingID string, opts *DashboardGetOptions) (*DashboardMeeting, *http.Response, error)
+	ListMeetingParticipants(ctx context.Context, meetingID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsResponse, *http.Response, error)
+	ListMeetingParticipantsQoS(ctx context.Context, meetingID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsQoSResponse, *http.Response, error)
+	GetMeetingParticipantQoS(ctx context.Context, meetingID string, participantID string, opts *DashboardGetOptions) (*DashboardParticipantQoS, *http.Response, error)
+	ListMeetingParticipantsSharing(ctx context.Context, meetingID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsSharingResponse, *http.Response, error)
+
+	ListWebinars(ctx context.Context, opts *DashboardListOptions) (*DashboardListWebinarsResponse, *http.Response, error)
+	GetWebinar(ctx context.Context, webinarID string, opts *DashboardGetOptions) (*DashboardMeeting, *http.Response, error)
+	ListWebinarParticipants(ctx context.Context, webinarID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsResponse, *http.Response, error)
+	ListWebinarParticipantsQoS(ctx context.Context, webinarID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsQoSResponse, *http.Response, error)
+	GetWebinarParticipantQoS(ctx context.Context, webinarID string, participantID string, opts *DashboardGetOptions) (*DashboardParticipantQoS, *http.Response, error)
+	ListWebinarParticipantsSharing(ctx context.Context, webinarID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsSharingResponse, *http.Response, error)
+
+	ListZoomRooms(ctx context.Context, opts *PaginationOptions) (*DashboardListZoomRoomsResponse, *http.Response, error)
+	GetZoomRoom(ctx context.Context, roomID string, opts *DashboardGetZoomRoomOptions) (*DashboardZoomRoom, *http.Response, error)
+	ListClientFeedback(ctx context.Context, opts *DashboardClientFeedbackOptions) (*DashboardListClientFeedbackResponse, *http.Response, error)
+	GetClientFeedback(ctx context.Context, feedbackID string, opts *DashboardClientFeedbackOptions) (*DashboardGetClientFeedbackResponse, *http.Response, error)
+}
+
+// DashboardService covers the metrics endpoints used to inspect the quality of live and past meetings and webinars.
+type DashboardService struct {
+	client *Client
+}
+
+var _ DashboardServicer = (*DashboardService)(nil)
+
+type DashboardListOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From          *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	GroupID       *string    `url:"group_id,omitempty"`
+	IncludeFields *string    `url:"include_fields,omitempty"`
+	To            *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+	Type          *string    `url:"type,omitempty"`
+}
+
+type DashboardGetOptions struct {
+	Type *string `url:"type,omitempty"`
+}
+
+type DashboardListMeetingsResponse struct {
+	*PaginationResponse
+
+	From     string              `json:"from"`
+	Meetings []*DashboardMeeting `json:"meetings"`
+	To       string              `json:"to"`
+}
+
+type DashboardListWebinarsResponse struct {
+	*PaginationResponse
+
+	From     string              `json:"from"`
+	To       string              `json:"to"`
+	Webinars []*DashboardMeeting `json:"webinars"`
+}
+
+// DashboardMeeting is returned for both meetings and webinars.
+type DashboardMeeting struct {
+	AudioQuality       string                                     `json:"audio_quality"`
+	CustomKeys         []*MeetingsCreateResponseSettingsCustomKey `json:"custom_keys"`
+	Dept               string                                     `json:"dept"`
+	Duration           string                                     `json:"duration"`
+	Email              string                                     `json:"email"`
+	EndTime            time.Time                                  `json:"end_time"`
+	Has3rdPartyAudio   bool                                       `json:"has_3rd_party_audio"`
+	HasArchiving       bool                                       `json:"has_archiving"`
+	HasPSTN            bool                                       `json:"has_pstn"`
+	HasRecording       bool                                       `json:"has_recording"`
+	HasScreenShare     bool                                       `json:"has_screen_share"`
+	HasSIP             bool                                       `json:"has_sip"`
+	HasVideo           bool                                       `json:"has_video"`
+	HasVoIP            bool                                       `json:"has_voip"`
+	Host               string                                     `json:"host"`
+	ID                 int64                                      `json:"id"`
+	InRoomParticipants int                                        `json:"in_room_participants"`
+	Participants       int                                        `json:"participants"`
+	ScreenShareQuality string                                     `json:"screen_share_quality"`
+	StartTime          time.Time                                  `json:"start_time"`
+	Topic              string                                     `json:"topic"`
+	TrackingFields     []*MeetingsCreateResponseTrackingField     `json:"tracking_fields"`
+	UserType           string                                     `json:"user_type"`
+	UUID               string                                     `json:"uuid"`
+	VideoQuality       string                                     `json:"video_quality"`
+}
+
+func (d *DashboardService) ListMeetings(ctx context.Context, opts *DashboardListOptions) (*DashboardListMeetingsResponse, *http.Response, error) {
+	out := &DashboardListMeetingsResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/meetings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// GetMeeting returns the metrics of a live or past meeting. meetingID may be a meeting ID or a meeting instance UUID.
+func (d *DashboardService) GetMeeting(ctx context.Context, meetingID string, opts *DashboardGetOptions) (*DashboardMeeting, *http.Response, error) {
+	out := &DashboardMeeting{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/meetings/"+escapeUUID(meetingID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardListParticipantsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	IncludeFields *string `url:"include_fields,omitempty"`
+	Type          *string `url:"type,omitempty"`
+}
+
+type DashboardListParticipantsResponse struct {
+	*PaginationResponse
+
+	Participants []*DashboardParticipant `json:"participants"`
+}
+
+type DashboardParticipant struct {
+	AudioQuality       string    `json:"audio_quality"`
+	Camera             string    `json:"camera"`
+	ConnectionType     string    `json:"connection_type"`
+	DataCenter         string    `json:"data_center"`
+	Device             string    `json:"device"`
+	Domain             string    `json:"domain"`
+	Email              string    `json:"email"`
+	HarddiskID         string    `json:"harddisk_id"`
+	ID                 string    `json:"id"`
+	IPAddress          string    `json:"ip_address"`
+	JoinTime           time.Time `json:"join_time"`
+	LeaveReason        string    `json:"leave_reason"`
+	LeaveTime          time.Time `json:"leave_time"`
+	Location           string    `json:"location"`
+	MacAddr            string    `json:"mac_addr"`
+	Microphone         string    `json:"microphone"`
+	NetworkType        string    `json:"network_type"`
+	PCName             string    `json:"pc_name"`
+	Recording          bool      `json:"recording"`
+	RegistrantID       string    `json:"registrant_id"`
+	ScreenShareQuality string    `json:"screen_share_quality"`
+	ShareApplication   bool      `json:"share_application"`
+	ShareDesktop       bool      `json:"share_desktop"`
+	ShareWhiteboard    bool      `json:"share_whiteboard"`
+	Speaker            string    `json:"speaker"`
+	UserID             string    `json:"user_id"`
+	UserName           string    `json:"user_name"`
+	Version            string    `json:"version"`
+	VideoQuality       string    `json:"video_quality"`
+}
+
+func (d *DashboardService) ListMeetingParticipants(ctx context.Context, meetingID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsResponse, *http.Response, error) {
+	out := &DashboardListParticipantsResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/meetings/"+escapeUUID(meetingID)+"/participants", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardListParticipantsQoSResponse struct {
+	*PaginationResponse
+
+	Participants []*DashboardParticipantQoS `json:"participants"`
+}
+
+func (d *DashboardService) ListMeetingParticipantsQoS(ctx context.Context, meetingID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsQoSResponse, *http.Response, error) {
+	out := &DashboardListParticipantsQoSResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/meetings/"+escapeUUID(meetingID)+"/participants/qos", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (d *DashboardService) GetMeetingParticipantQoS(ctx context.Context, meetingID string, participantID string, opts *DashboardGetOptions) (*DashboardParticipantQoS, *http.Response, error) {
+	out := &DashboardParticipantQoS{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/meetings/"+escapeUUID(meetingID)+"/participants/"+url.QueryEscape(participantID)+"/qos", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardListParticipantsSharingResponse struct {
+	*PaginationResponse
+
+	Participants []*DashboardParticipantSharing `json:"participants"`
+}
+
+type DashboardParticipantSharing struct {
+	Details  []*DashboardParticipantSharingDetail `json:"details"`
+	ID       string                               `json:"id"`
+	UserID   string                               `json:"user_id"`
+	UserName string                               `json:"user_name"`
+}
+
+type DashboardParticipantSharingDetail struct {
+	Content   string `json:"content"`
+	EndTime   string `json:"end_time"`
+	StartTime string `json:"start_time"`
+}
+
+func (d *DashboardService) ListMeetingParticipantsSharing(ctx context.Context, meetingID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsSharingResponse, *http.Response, error) {
+	out := &DashboardListParticipantsSharingResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/meetings/"+escapeUUID(meetingID)+"/participants/sharing", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (d *DashboardService) ListWebinars(ctx context.Context, opts *DashboardListOptions) (*DashboardListWebinarsResponse, *http.Response, error) {
+	out := &DashboardListWebinarsResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/webinars", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// GetWebinar returns the metrics of a live or past webinar. webinarID may be a webinar ID or a webinar instance UUID.
+func (d *DashboardService) GetWebinar(ctx context.Context, webinarID string, opts *DashboardGetOptions) (*DashboardMeeting, *http.Response, error) {
+	out := &DashboardMeeting{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/webinars/"+escapeUUID(webinarID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (d *DashboardService) ListWebinarParticipants(ctx context.Context, webinarID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsResponse, *http.Response, error) {
+	out := &DashboardListParticipantsResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/webinars/"+escapeUUID(webinarID)+"/participants", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (d *DashboardService) ListWebinarParticipantsQoS(ctx context.Context, webinarID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsQoSResponse, *http.Response, error) {
+	out := &DashboardListParticipantsQoSResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/webinars/"+escapeUUID(webinarID)+"/participants/qos", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (d *DashboardService) GetWebinarParticipantQoS(ctx context.Context, webinarID string, participantID string, opts *DashboardGetOptions) (*DashboardParticipantQoS, *http.Response, error) {
+	out := &DashboardParticipantQoS{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/webinars/"+escapeUUID(webinarID)+"/participants/"+url.QueryEscape(participantID)+"/qos", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (d *DashboardService) ListWebinarParticipantsSharing(ctx context.Context, webinarID string, opts *DashboardListParticipantsOptions) (*DashboardListParticipantsSharingResponse, *http.Response, error) {
+	out := &DashboardListParticipantsSharingResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/webinars/"+escapeUUID(webinarID)+"/participants/sharing", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardListZoomRoomsResponse struct {
+	*PaginationResponse
+
+	ZoomRooms []*DashboardZoomRoom `json:"zoom_rooms"`
+}
+
+type DashboardZoomRoom struct {
+	AccountType   string                      `json:"account_type"`
+	CalendarName  string                      `json:"calendar_name"`
+	Camera        string                      `json:"camera"`
+	DeviceIP      string                      `json:"device_ip"`
+	Email         string                      `json:"email"`
+	Health        string                      `json:"health"`
+	ID            string                      `json:"id"`
+	Issues        []string                    `json:"issues"`
+	LastStartTime time.Time                   `json:"last_start_time"`
+	LiveMeeting   *DashboardZoomRoomMeeting   `json:"live_meeting"`
+	Location      string                      `json:"location"`
+	LocationID    string                      `json:"location_id"`
+	Microphone    string                      `json:"microphone"`
+	PastMeetings  []*DashboardZoomRoomMeeting `json:"past_meetings"`
+	RoomName      string                      `json:"room_name"`
+	Speaker       string                      `json:"speaker"`
+	Status        string                      `json:"status"`
+}
+
+type DashboardZoomRoomMeeting struct {
+	Duration     string    `json:"duration"`
+	EndTime      time.Time `json:"end_time"`
+	Host         string    `json:"host"`
+	ID           int64     `json:"id"`
+	Participants int       `json:"participants"`
+	StartTime    time.Time `json:"start_time"`
+	Topic        string    `json:"topic"`
+	UUID         string    `json:"uuid"`
+}
+
+func (d *DashboardService) ListZoomRooms(ctx context.Context, opts *PaginationOptions) (*DashboardListZoomRoomsResponse, *http.Response, error) {
+	out := &DashboardListZoomRoomsResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/zoomrooms", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardGetZoomRoomOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To   *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+func (d *DashboardService) GetZoomRoom(ctx context.Context, roomID string, opts *DashboardGetZoomRoomOptions) (*DashboardZoomRoom, *http.Response, error) {
+	out := &DashboardZoomRoom{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/zoomrooms/"+url.QueryEscape(roomID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardClientFeedbackOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To   *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+type DashboardListClientFeedbackResponse struct {
+	ClientFeedbacks []*DashboardClientFeedback `json:"client_feedbacks"`
+	From            string                     `json:"from"`
+	To              string                     `json:"to"`
+	TotalRecords    int                        `json:"total_records"`
+}
+
+type DashboardClientFeedback struct {
+	FeedbackID        string `json:"feedback_id"`
+	FeedbackName      string `json:"feedback_name"`
+	ParticipantsCount int    `json:"participants_count"`
+}
+
+func (d *DashboardService) ListClientFeedback(ctx context.Context, opts *DashboardClientFeedbackOptions) (*DashboardListClientFeedbackResponse, *http.Response, error) {
+	out := &DashboardListClientFeedbackResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/client/feedback", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type DashboardGetClientFeedbackResponse struct {
+	*PaginationResponse
+
+	ClientFeedbackDetails []*DashboardClientFeedbackDetail `json:"client_feedback_details"`
+	From                  string                           `json:"from"`
+	To                    string                           `json:"to"`
+}
+
+type DashboardClientFeedbackDetail struct {
+	Email           string    `json:"email"`
+	MeetingID       string    `json:"meeting_id"`
+	ParticipantID   string    `json:"participant_id"`
+	ParticipantName string    `json:"participant_name"`
+	Time            time.Time `json:"time"`
+}
+
+func (d *DashboardService) GetClientFeedback(ctx context.Context, feedbackID string, opts *DashboardClientFeedbackOptions) (*DashboardGetClientFeedbackResponse, *http.Response, error) {
+	out := &DashboardGetClientFeedbackResponse{}
+
+	res, err := d.client.request(ctx, http.MethodGet, "/metrics/client/feedback/"+url.QueryEscape(feedbackID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
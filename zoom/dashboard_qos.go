@@ -0,0 +1,225 @@
+package zoom
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	QoSStreamAudioInput        QoSStream = "audio_input"
+	QoSStreamAudioOutput       QoSStream = "audio_output"
+	QoSStreamVideoInput        QoSStream = "video_input"
+	QoSStreamVideoOutput       QoSStream = "video_output"
+	QoSStreamScreenShareInput  QoSStream = "as_input"
+	QoSStreamScreenShareOutput QoSStream = "as_output"
+
+	// Thresholds above which Zoom considers a stream's quality poor.
+	QoSPoorLatencyMs   = 150
+	QoSPoorJitterMs    = 40
+	QoSPoorLossPercent = 2
+)
+
+// QoSStream identifies one of the audio, video or screen share streams of a participant.
+type QoSStream string
+
+func (q QoSStream) String() string {
+	return string(q)
+}
+
+type DashboardParticipantQoS struct {
+	Device     string                  `json:"device"`
+	Domain     string                  `json:"domain"`
+	HarddiskID string                  `json:"harddisk_id"`
+	IPAddress  string                  `json:"ip_address"`
+	JoinTime   time.Time               `json:"join_time"`
+	LeaveTime  time.Time               `json:"leave_time"`
+	Location   string                  `json:"location"`
+	MacAddr    string                  `json:"mac_addr"`
+	PCName     string                  `json:"pc_name"`
+	UserID     string                  `json:"user_id"`
+	UserName   string                  `json:"user_name"`
+	UserQoS    []*DashboardQoSInterval `json:"user_qos"`
+	Version    string                  `json:"version"`
+}
+
+// DashboardQoSInterval holds the quality of each of a participant's streams over a single sampling interval.
+type DashboardQoSInterval struct {
+	AudioInput        *QoS         `json:"audio_input"`
+	AudioOutput       *QoS         `json:"audio_output"`
+	CPUUsage          *QoSCPUUsage `json:"cpu_usage"`
+	DateTime          time.Time    `json:"date_time"`
+	ScreenShareInput  *QoS         `json:"as_input"`
+	ScreenShareOutput *QoS         `json:"as_output"`
+	VideoInput        *QoS         `json:"video_input"`
+	VideoOutput       *QoS         `json:"video_output"`
+}
+
+// Streams returns the interval's streams that have QoS data, keyed by stream.
+func (d *DashboardQoSInterval) Streams() map[QoSStream]*QoS {
+	streams := map[QoSStream]*QoS{}
+
+	for stream, qos := range map[QoSStream]*QoS{
+		QoSStreamAudioInput:        d.AudioInput,
+		QoSStreamAudioOutput:       d.AudioOutput,
+		QoSStreamVideoInput:        d.VideoInput,
+		QoSStreamVideoOutput:       d.VideoOutput,
+		QoSStreamScreenShareInput:  d.ScreenShareInput,
+		QoSStreamScreenShareOutput: d.ScreenShareOutput,
+	} {
+		if qos != nil {
+			streams[stream] = qos
+		}
+	}
+
+	return streams
+}
+
+// QoS values are reported by Zoom as strings with units, such as "20 ms" or "0.1 %". Use the accessor methods to read them as numbers.
+type QoS struct {
+	AvgLoss    string `json:"avg_loss"`
+	Bitrate    string `json:"bitrate"`
+	FrameRate  string `json:"frame_rate"`
+	Jitter     string `json:"jitter"`
+	Latency    string `json:"latency"`
+	MaxLoss    string `json:"max_loss"`
+	Resolution string `json:"resolution"`
+}
+
+func (q *QoS) LatencyMs() (float64, bool) {
+	return parseQoSValue(q.Latency)
+}
+
+func (q *QoS) JitterMs() (float64, bool) {
+	return parseQoSValue(q.Jitter)
+}
+
+func (q *QoS) AvgLossPercent() (float64, bool) {
+	return parseQoSValue(q.AvgLoss)
+}
+
+func (q *QoS) MaxLossPercent() (float64, bool) {
+	return parseQoSValue(q.MaxLoss)
+}
+
+func (q *QoS) BitrateKbps() (float64, bool) {
+	return parseQoSValue(q.Bitrate)
+}
+
+func (q *QoS) FrameRateFPS() (float64, bool) {
+	return parseQoSValue(q.FrameRate)
+}
+
+type QoSCPUUsage struct {
+	SystemMaxCPUUsage string `json:"system_max_cpu_usage"`
+	ZoomAvgCPUUsage   string `json:"zoom_avg_cpu_usage"`
+	ZoomMaxCPUUsage   string `json:"zoom_max_cpu_usage"`
+	ZoomMinCPUUsage   string `json:"zoom_min_cpu_usage"`
+}
+
+// parseQoSValue parses the leading number of a value such as "20 ms", "0.1%" or "27.14 kbps".
+func parseQoSValue(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+
+	end := 0
+	for end < len(s) && (s[end] == '.' || s[end] == '-' || (s[end] >= '0' && s[end] <= '9')) {
+		end++
+	}
+
+	f, err := strconv.ParseFloat(s[:end], 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return f, true
+}
+
+// QoSSample is the quality of one stream during one interval.
+type QoSSample struct {
+	DateTime       time.Time
+	Stream         QoSStream
+	LatencyMs      float64
+	JitterMs       float64
+	AvgLossPercent float64
+	MaxLossPercent float64
+	// Score is the worst of latency, jitter and average loss relative to Zoom's poor quality thresholds. A score above 1 is poor.
+	Score float64
+}
+
+func (q *QoSSample) Poor() bool {
+	return q.Score > 1
+}
+
+type QoSSummary struct {
+	Samples     int
+	PoorSamples int
+	// Max holds, per stream, the highest latency, jitter and loss seen across all intervals.
+	Max map[QoSStream]*QoSSample
+	// Worst holds the worst samples ordered from worst to best.
+	Worst []*QoSSample
+}
+
+// SummarizeQoS scores every stream of every interval of a participant and returns the n worst samples along with the
+// per-stream maximums. n less than or equal to zero returns every sample.
+func SummarizeQoS(participant *DashboardParticipantQoS, n int) *QoSSummary {
+	summary := &QoSSummary{
+		Max: map[QoSStream]*QoSSample{},
+	}
+
+	var samples []*QoSSample
+	for _, interval := range participant.UserQoS {
+		for stream, qos := range interval.Streams() {
+			sample := &QoSSample{
+				DateTime: interval.DateTime,
+				Stream:   stream,
+			}
+
+			sample.LatencyMs, _ = qos.LatencyMs()
+			sample.JitterMs, _ = qos.JitterMs()
+			sample.AvgLossPercent, _ = qos.AvgLossPercent()
+			sample.MaxLossPercent, _ = qos.MaxLossPercent()
+
+			sample.Score = max(sample.LatencyMs/QoSPoorLatencyMs, sample.JitterMs/QoSPoorJitterMs, sample.AvgLossPercent/QoSPoorLossPercent)
+
+			samples = append(samples, sample)
+
+			summary.Samples++
+			if sample.Poor() {
+				summary.PoorSamples++
+			}
+
+			m, ok := summary.Max[stream]
+			if !ok {
+				m = &QoSSample{Stream: stream}
+				summary.Max[stream] = m
+			}
+
+			m.LatencyMs = max(m.LatencyMs, sample.LatencyMs)
+			m.JitterMs = max(m.JitterMs, sample.JitterMs)
+			m.AvgLossPercent = max(m.AvgLossPercent, sample.AvgLossPercent)
+			m.MaxLossPercent = max(m.MaxLossPercent, sample.MaxLossPercent)
+			m.Score = max(m.Score, sample.Score)
+		}
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool {
+		if samples[i].Score != samples[j].Score {
+			return samples[i].Score > samples[j].Score
+		}
+
+		if !samples[i].DateTime.Equal(samples[j].DateTime) {
+			return samples[i].DateTime.Before(samples[j].DateTime)
+		}
+
+		return samples[i].Stream < samples[j].Stream
+	})
+
+	if n > 0 && len(samples) > n {
+		samples = samples[:n]
+	}
+
+	summary.Worst = samples
+
+	return summary
+}
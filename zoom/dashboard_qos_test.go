@@ -0,0 +1,68 @@
+package zoom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQoSValue(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := map[string]float64{
+		"20 ms":      20,
+		"0.1 %":      0.1,
+		"2.5%":       2.5,
+		"27.14 kbps": 27.14,
+		"25 fps":     25,
+	}
+
+	for s, expected := range tests {
+		v, ok := parseQoSValue(s)
+		assert.True(ok, s)
+		assert.Equal(expected, v, s)
+	}
+
+	_, ok := parseQoSValue("")
+	assert.False(ok)
+
+	_, ok = parseQoSValue("-")
+	assert.False(ok)
+}
+
+func TestSummarizeQoS(t *testing.T) {
+	assert := assert.New(t)
+
+	participant := &DashboardParticipantQoS{}
+	err := json.Unmarshal([]byte(`{
+		"user_qos": [
+			{
+				"date_time": "2024-01-01T10:00:00Z",
+				"audio_input": {"latency": "20 ms", "jitter": "5 ms", "avg_loss": "0.1 %", "max_loss": "0.5 %"},
+				"video_input": {"latency": "300 ms", "jitter": "10 ms", "avg_loss": "0 %", "max_loss": "0 %"}
+			},
+			{
+				"date_time": "2024-01-01T10:01:00Z",
+				"audio_input": {"latency": "30 ms", "jitter": "80 ms", "avg_loss": "1 %", "max_loss": "6 %"}
+			}
+		]
+	}`), participant)
+	assert.NoError(err)
+
+	summary := SummarizeQoS(participant, 2)
+
+	assert.Equal(3, summary.Samples)
+	assert.Equal(2, summary.PoorSamples)
+
+	assert.Len(summary.Worst, 2)
+	assert.Equal(QoSStreamVideoInput, summary.Worst[0].Stream)
+	assert.Equal(float64(2), summary.Worst[0].Score)
+	assert.Equal(QoSStreamAudioInput, summary.Worst[1].Stream)
+	assert.Equal(time.Date(2024, 1, 1, 10, 1, 0, 0, time.UTC), summary.Worst[1].DateTime)
+
+	assert.Equal(float64(30), summary.Max[QoSStreamAudioInput].LatencyMs)
+	assert.Equal(float64(80), summary.Max[QoSStreamAudioInput].JitterMs)
+	assert.Equal(float64(6), summary.Max[QoSStreamAudioInput].MaxLossPercent)
+}
@@ -0,0 +1,37 @@
+package zoom
+
+import "time"
+
+// DateRange is an inclusive range of calendar days.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// SplitDateRange splits the days between from and to (inclusive) into consecutive ranges spanning at most maxDays days each.
+// Many Zoom endpoints reject from/to ranges longer than a month, so callers use this to request a longer period in chunks.
+func SplitDateRange(from, to time.Time, maxDays int) []DateRange {
+	from = truncateDay(from)
+	to = truncateDay(to)
+
+	if maxDays < 1 || to.Before(from) {
+		return nil
+	}
+
+	var ranges []DateRange
+	for start := from; !start.After(to); start = start.AddDate(0, 0, maxDays) {
+		end := start.AddDate(0, 0, maxDays-1)
+		if end.After(to) {
+			end = to
+		}
+
+		ranges = append(ranges, DateRange{From: start, To: end})
+	}
+
+	return ranges
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
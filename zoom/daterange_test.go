@@ -0,0 +1,41 @@
+package zoom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitDateRange(t *testing.T) {
+	assert := assert.New(t)
+
+	from := time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 5, 8, 0, 0, 0, time.UTC)
+
+	ranges := SplitDateRange(from, to, 30)
+
+	assert.Equal([]DateRange{
+		{From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{From: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+	}, ranges)
+}
+
+func TestSplitDateRange_SingleDay(t *testing.T) {
+	assert := assert.New(t)
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal([]DateRange{{From: day, To: day}}, SplitDateRange(day, day, 30))
+}
+
+func TestSplitDateRange_Invalid(t *testing.T) {
+	assert := assert.New(t)
+
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Nil(SplitDateRange(from, to, 30))
+	assert.Nil(SplitDateRange(to, from, 0))
+}
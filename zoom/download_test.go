@@ -0,0 +1,110 @@
+package zoom
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom/tokenmutex"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDownloadTestClient(t *testing.T) *Client {
+	mutex := tokenmutex.NewDefault()
+	err := mutex.Set(context.Background(), "token", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewClient(&http.Client{}, "", "", "", mutex)
+}
+
+func TestClient_download(t *testing.T) {
+	assert := assert.New(t)
+
+	content := []byte("recording contents")
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer token", r.Header.Get("Authorization"))
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}))
+	defer s.Close()
+
+	sum := sha256.Sum256(content)
+	buf := &bytes.Buffer{}
+
+	n, _, err := newDownloadTestClient(t).download(context.Background(), s.URL, buf, &DownloadOptions{
+		Size:     int64(len(content)),
+		Hash:     sha256.New(),
+		Checksum: sum[:],
+	})
+
+	assert.NoError(err)
+	assert.Equal(int64(len(content)), n)
+	assert.Equal(content, buf.Bytes())
+}
+
+func TestClient_download_Resume(t *testing.T) {
+	assert := assert.New(t)
+
+	content := []byte("recording contents")
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("bytes=10-", r.Header.Get("Range"))
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}))
+	defer s.Close()
+
+	buf := &bytes.Buffer{}
+
+	n, res, err := newDownloadTestClient(t).download(context.Background(), s.URL, buf, &DownloadOptions{
+		Offset: 10,
+		Size:   int64(len(content)),
+	})
+
+	assert.NoError(err)
+	assert.Equal(http.StatusPartialContent, res.StatusCode)
+	assert.Equal(int64(len(content)-10), n)
+	assert.Equal(content[10:], buf.Bytes())
+}
+
+func TestClient_download_RangeIgnored(t *testing.T) {
+	assert := assert.New(t)
+
+	content := []byte("recording contents")
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(content)
+	}))
+	defer s.Close()
+
+	buf := &bytes.Buffer{}
+
+	_, _, err := newDownloadTestClient(t).download(context.Background(), s.URL, buf, &DownloadOptions{
+		Offset: 10,
+	})
+
+	assert.NoError(err)
+	assert.Equal(content[10:], buf.Bytes())
+}
+
+func TestClient_download_Mismatch(t *testing.T) {
+	assert := assert.New(t)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("foo"))
+	}))
+	defer s.Close()
+
+	_, _, err := newDownloadTestClient(t).download(context.Background(), s.URL, &bytes.Buffer{}, &DownloadOptions{
+		Size: 4,
+	})
+	assert.ErrorIs(err, ErrDownloadSizeMismatch)
+
+	_, _, err = newDownloadTestClient(t).download(context.Background(), s.URL, &bytes.Buffer{}, &DownloadOptions{
+		Hash:     sha256.New(),
+		Checksum: []byte("bar"),
+	})
+	assert.ErrorIs(err, ErrDownloadChecksumMismatch)
+}
@@ -0,0 +1,322 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// GroupsMaxMembersPerAdd is the maximum number of members Zoom accepts in a single add members request.
+	GroupsMaxMembersPerAdd = 30
+
+	GroupsUpdateMemberActionMove       GroupsUpdateMemberAction = "move"
+	GroupsUpdateMemberActionSetPrimary GroupsUpdateMemberAction = "set_primary"
+)
+
+type GroupsUpdateMemberAction string
+
+func (g GroupsUpdateMemberAction) String() string {
+	return string(g)
+}
+
+type GroupsServicer interface {
+	List(ctx context.Context, opts *PaginationOptions) (*GroupsListResponse, *http.Response, error)
+	Get(ctx context.Context, groupID string) (*Group, *http.Response, error)
+	Create(ctx context.Context, opts *GroupsCreateOptions) (*Group, *http.Response, error)
+	Update(ctx context.Context, groupID string, opts *GroupsUpdateOptions) (*http.Response, error)
+	Delete(ctx context.Context, groupID string) (*http.Response, error)
+
+	ListMembers(ctx context.Context, groupID string, opts *PaginationOptions) (*GroupsListMembersResponse, *http.Response, error)
+	AddMembers(ctx context.Context, groupID string, opts *GroupsAddMembersOptions) (*GroupsAddMembersResponse, *http.Response, error)
+	AddMembersBatched(ctx context.Context, groupID string, members []*UserIdentifier) ([]string, error)
+	RemoveMember(ctx context.Context, groupID string, memberID string) (*http.Response, error)
+	UpdateMember(ctx context.Context, groupID string, memberID string, opts *GroupsUpdateMemberOptions) (*http.Response, error)
+
+	ListAdmins(ctx context.Context, groupID string, opts *PaginationOptions) (*GroupsListAdminsResponse, *http.Response, error)
+	AddAdmins(ctx context.Context, groupID string, opts *GroupsAddAdminsOptions) (*GroupsAddAdminsResponse, *http.Response, error)
+	RemoveAdmin(ctx context.Context, groupID string, userID string) (*http.Response, error)
+
+	GetSettings(ctx context.Context, groupID string, opts *GroupsGetSettingsOptions) (*Settings, *http.Response, error)
+	UpdateSettings(ctx context.Context, groupID string, opts *Settings) (*http.Response, error)
+	GetLockSettings(ctx context.Context, groupID string) (*Settings, *http.Response, error)
+	UpdateLockSettings(ctx context.Context, groupID string, opts *Settings) (*http.Response, error)
+}
+
+type GroupsService struct {
+	client *Client
+}
+
+var _ GroupsServicer = (*GroupsService)(nil)
+
+func groupPath(groupID string) string {
+	return "/groups/" + url.QueryEscape(groupID)
+}
+
+type GroupsListResponse struct {
+	*PaginationResponse
+
+	Groups []*Group `json:"groups"`
+}
+
+type Group struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	TotalMembers int    `json:"total_members"`
+}
+
+func (g *GroupsService) List(ctx context.Context, opts *PaginationOptions) (*GroupsListResponse, *http.Response, error) {
+	out := &GroupsListResponse{}
+
+	res, err := g.client.request(ctx, http.MethodGet, "/groups", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (g *GroupsService) Get(ctx context.Context, groupID string) (*Group, *http.Response, error) {
+	out := &Group{}
+
+	res, err := g.client.request(ctx, http.MethodGet, groupPath(groupID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type GroupsCreateOptions struct {
+	Name string `json:"name"`
+}
+
+func (g *GroupsService) Create(ctx context.Context, opts *GroupsCreateOptions) (*Group, *http.Response, error) {
+	out := &Group{}
+
+	res, err := g.client.request(ctx, http.MethodPost, "/groups", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type GroupsUpdateOptions struct {
+	Name *string `json:"name,omitempty"`
+}
+
+func (g *GroupsService) Update(ctx context.Context, groupID string, opts *GroupsUpdateOptions) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodPatch, groupPath(groupID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (g *GroupsService) Delete(ctx context.Context, groupID string) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodDelete, groupPath(groupID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type GroupsListMembersResponse struct {
+	*PaginationResponse
+
+	Members []*GroupsMember `json:"members"`
+}
+
+type GroupsMember struct {
+	Email        string `json:"email"`
+	FirstName    string `json:"first_name"`
+	ID           string `json:"id"`
+	LastName     string `json:"last_name"`
+	PrimaryGroup bool   `json:"primary_group"`
+	Type         int    `json:"type"`
+}
+
+func (g *GroupsService) ListMembers(ctx context.Context, groupID string, opts *PaginationOptions) (*GroupsListMembersResponse, *http.Response, error) {
+	out := &GroupsListMembersResponse{}
+
+	res, err := g.client.request(ctx, http.MethodGet, groupPath(groupID)+"/members", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// UserIdentifier identifies a user by ID or email address when adding group members, group admins or role members.
+type UserIdentifier struct {
+	Email *string `json:"email,omitempty"`
+	ID    *string `json:"id,omitempty"`
+}
+
+type GroupsAddMembersOptions struct {
+	Members []*UserIdentifier `json:"members"`
+}
+
+type GroupsAddMembersResponse struct {
+	AddedAt time.Time `json:"added_at"`
+	IDs     string    `json:"ids"`
+}
+
+// AddMembers adds up to GroupsMaxMembersPerAdd members to a group. Use AddMembersBatched to add more.
+func (g *GroupsService) AddMembers(ctx context.Context, groupID string, opts *GroupsAddMembersOptions) (*GroupsAddMembersResponse, *http.Response, error) {
+	out := &GroupsAddMembersResponse{}
+
+	res, err := g.client.request(ctx, http.MethodPost, groupPath(groupID)+"/members", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// AddMembersBatched adds any number of members to a group, splitting them into requests of GroupsMaxMembersPerAdd members.
+// It returns the comma separated IDs reported by each request, stopping at the first request that fails.
+func (g *GroupsService) AddMembersBatched(ctx context.Context, groupID string, members []*UserIdentifier) ([]string, error) {
+	var ids []string
+
+	for start := 0; start < len(members); start += GroupsMaxMembersPerAdd {
+		end := min(start+GroupsMaxMembersPerAdd, len(members))
+
+		out, _, err := g.AddMembers(ctx, groupID, &GroupsAddMembersOptions{
+			Members: members[start:end],
+		})
+		if err != nil {
+			return ids, fmt.Errorf("adding members %d to %d: %w", start, end-1, err)
+		}
+
+		ids = append(ids, out.IDs)
+	}
+
+	return ids, nil
+}
+
+func (g *GroupsService) RemoveMember(ctx context.Context, groupID string, memberID string) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodDelete, groupPath(groupID)+"/members/"+url.QueryEscape(memberID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type GroupsUpdateMemberOptions struct {
+	Action GroupsUpdateMemberAction `json:"action"`
+	// TargetGroupID is required when moving a member.
+	TargetGroupID *string `json:"target_group_id,omitempty"`
+}
+
+// UpdateMember moves a member to another group or sets the group as the member's primary group.
+func (g *GroupsService) UpdateMember(ctx context.Context, groupID string, memberID string, opts *GroupsUpdateMemberOptions) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodPost, groupPath(groupID)+"/members/"+url.QueryEscape(memberID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type GroupsListAdminsResponse struct {
+	*PaginationResponse
+
+	Admins []*GroupsAdmin `json:"admins"`
+}
+
+type GroupsAdmin struct {
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	UserID string `json:"user_id"`
+}
+
+func (g *GroupsService) ListAdmins(ctx context.Context, groupID string, opts *PaginationOptions) (*GroupsListAdminsResponse, *http.Response, error) {
+	out := &GroupsListAdminsResponse{}
+
+	res, err := g.client.request(ctx, http.MethodGet, groupPath(groupID)+"/admins", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type GroupsAddAdminsOptions struct {
+	Admins []*UserIdentifier `json:"admins"`
+}
+
+type GroupsAddAdminsResponse struct {
+	AddedAt time.Time `json:"added_at"`
+	IDs     string    `json:"ids"`
+}
+
+func (g *GroupsService) AddAdmins(ctx context.Context, groupID string, opts *GroupsAddAdminsOptions) (*GroupsAddAdminsResponse, *http.Response, error) {
+	out := &GroupsAddAdminsResponse{}
+
+	res, err := g.client.request(ctx, http.MethodPost, groupPath(groupID)+"/admins", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (g *GroupsService) RemoveAdmin(ctx context.Context, groupID string, userID string) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodDelete, groupPath(groupID)+"/admins/"+url.QueryEscape(userID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type GroupsGetSettingsOptions struct {
+	CustomQueryFields *string `url:"custom_query_fields,omitempty"`
+}
+
+func (g *GroupsService) GetSettings(ctx context.Context, groupID string, opts *GroupsGetSettingsOptions) (*Settings, *http.Response, error) {
+	out := &Settings{}
+
+	res, err := g.client.request(ctx, http.MethodGet, groupPath(groupID)+"/settings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (g *GroupsService) UpdateSettings(ctx context.Context, groupID string, opts *Settings) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodPatch, groupPath(groupID)+"/settings", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (g *GroupsService) GetLockSettings(ctx context.Context, groupID string) (*Settings, *http.Response, error) {
+	out := &Settings{}
+
+	res, err := g.client.request(ctx, http.MethodGet, groupPath(groupID)+"/lock_settings", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (g *GroupsService) UpdateLockSettings(ctx context.Context, groupID string, opts *Settings) (*http.Response, error) {
+	res, err := g.client.request(ctx, http.MethodPatch, groupPath(groupID)+"/lock_settings", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
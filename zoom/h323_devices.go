@@ -0,0 +1,112 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	H323DeviceProtocolH323 H323DeviceProtocol = "H.323"
+	H323DeviceProtocolSIP  H323DeviceProtocol = "SIP"
+
+	H323DeviceEncryptionAuto H323DeviceEncryption = "auto"
+	H323DeviceEncryptionYes  H323DeviceEncryption = "yes"
+	H323DeviceEncryptionNo   H323DeviceEncryption = "no"
+)
+
+type H323DeviceProtocol string
+
+func (h H323DeviceProtocol) String() string {
+	return string(h)
+}
+
+type H323DeviceEncryption string
+
+func (h H323DeviceEncryption) String() string {
+	return string(h)
+}
+
+type H323DevicesServicer interface {
+	List(ctx context.Context, opts *PaginationOptions) (*H323DevicesListResponse, *http.Response, error)
+	Create(ctx context.Context, opts *H323DevicesCreateOptions) (*H323Device, *http.Response, error)
+	Update(ctx context.Context, deviceID string, opts *H323DevicesUpdateOptions) (*http.Response, error)
+	Delete(ctx context.Context, deviceID string) (*http.Response, error)
+}
+
+// H323DevicesService covers the H.323 and SIP room systems saved on the account, which meetings can call out to.
+type H323DevicesService struct {
+	client *Client
+}
+
+var _ H323DevicesServicer = (*H323DevicesService)(nil)
+
+type H323DevicesListResponse struct {
+	*PaginationResponse
+
+	Devices []*H323Device `json:"devices"`
+}
+
+type H323Device struct {
+	Encryption H323DeviceEncryption `json:"encryption"`
+	ID         string               `json:"id"`
+	IP         string               `json:"ip"`
+	Name       string               `json:"name"`
+	Protocol   H323DeviceProtocol   `json:"protocol"`
+}
+
+func (h *H323DevicesService) List(ctx context.Context, opts *PaginationOptions) (*H323DevicesListResponse, *http.Response, error) {
+	out := &H323DevicesListResponse{}
+
+	res, err := h.client.request(ctx, http.MethodGet, "/h323/devices", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type H323DevicesCreateOptions struct {
+	Encryption H323DeviceEncryption `json:"encryption"`
+	// IP is the device's IP address or domain name.
+	IP       string             `json:"ip"`
+	Name     string             `json:"name"`
+	Protocol H323DeviceProtocol `json:"protocol"`
+}
+
+func (h *H323DevicesService) Create(ctx context.Context, opts *H323DevicesCreateOptions) (*H323Device, *http.Response, error) {
+	out := &H323Device{}
+
+	res, err := h.client.request(ctx, http.MethodPost, "/h323/devices", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type H323DevicesUpdateOptions struct {
+	Encryption *H323DeviceEncryption `json:"encryption,omitempty"`
+	IP         *string               `json:"ip,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Protocol   *H323DeviceProtocol   `json:"protocol,omitempty"`
+}
+
+func (h *H323DevicesService) Update(ctx context.Context, deviceID string, opts *H323DevicesUpdateOptions) (*http.Response, error) {
+	res, err := h.client.request(ctx, http.MethodPatch, "/h323/devices/"+url.QueryEscape(deviceID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (h *H323DevicesService) Delete(ctx context.Context, deviceID string) (*http.Response, error) {
+	res, err := h.client.request(ctx, http.MethodDelete, "/h323/devices/"+url.QueryEscape(deviceID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
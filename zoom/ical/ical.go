@@ -0,0 +1,447 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const (
+	prodID = "-//go-zoom//ical//EN"
+
+	// maxLineOctets is the length lines are folded at, excluding the line break.
+	maxLineOctets = 75
+
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+	dateLayout        = "20060102"
+)
+
+// Event is a VEVENT. Times are in Timezone, or UTC when Timezone is empty.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	// Timezone is the IANA timezone the event is scheduled in.
+	Timezone   string
+	Recurrence *zoom.Recurrence
+	// RRule is the RRULE of a decoded event, written as is by Encode when Recurrence is nil. CreateOptions converts it
+	// into a recurrence, so an RRULE Zoom cannot schedule fails only its own event.
+	RRule string
+	// ExDates are the start times of deleted occurrences of a recurring event.
+	ExDates []time.Time
+	// RecurrenceID is the original start time of the occurrence of a recurring event this event overrides.
+	RecurrenceID time.Time
+	// Stamp is when the event was created, the time of encoding by default.
+	Stamp time.Time
+}
+
+// Encode writes the events as a VCALENDAR, including a VTIMEZONE for every timezone the events use.
+func Encode(w io.Writer, events []*Event) error {
+	lw := &lineWriter{w: bufio.NewWriter(w)}
+
+	lw.line("BEGIN", "VCALENDAR")
+	lw.line("VERSION", "2.0")
+	lw.line("PRODID", prodID)
+	lw.line("CALSCALE", "GREGORIAN")
+
+	years := map[string][2]int{}
+	var timezones []string
+	for _, e := range events {
+		if len(e.Timezone) == 0 {
+			continue
+		}
+
+		from, to := e.Start.Year(), e.Start.Year()+1
+		if e.Recurrence != nil && !e.Recurrence.EndDateTime.IsZero() {
+			to = max(to, e.Recurrence.EndDateTime.Year())
+		}
+
+		span, ok := years[e.Timezone]
+		if !ok {
+			timezones = append(timezones, e.Timezone)
+			span = [2]int{from, to}
+		}
+
+		years[e.Timezone] = [2]int{min(span[0], from), max(span[1], to)}
+	}
+
+	for _, timezone := range timezones {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return fmt.Errorf("loading timezone: %w", err)
+		}
+
+		writeTimezone(lw, loc, years[timezone][0], years[timezone][1])
+	}
+
+	for _, e := range events {
+		err := writeEvent(lw, e)
+		if err != nil {
+			return fmt.Errorf("writing event %s: %w", e.UID, err)
+		}
+	}
+
+	lw.line("END", "VCALENDAR")
+
+	if lw.err != nil {
+		return lw.err
+	}
+
+	return lw.w.Flush()
+}
+
+func writeEvent(lw *lineWriter, e *Event) error {
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return fmt.Errorf("loading timezone: %w", err)
+	}
+
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	lw.line("BEGIN", "VEVENT")
+	lw.line("UID", escapeText(e.UID))
+	lw.line("DTSTAMP", stamp.UTC().Format(utcDateTimeLayout))
+	lw.time("DTSTART", e.Start, e.Timezone, loc)
+	lw.time("DTEND", e.End, e.Timezone, loc)
+
+	if !e.RecurrenceID.IsZero() {
+		lw.time("RECURRENCE-ID", e.RecurrenceID, e.Timezone, loc)
+	}
+
+	if e.Recurrence != nil {
+		rrule, err := formatRRule(e.Recurrence, loc)
+		if err != nil {
+			return err
+		}
+
+		lw.line("RRULE", rrule)
+	} else if len(e.RRule) > 0 {
+		lw.line("RRULE", e.RRule)
+	}
+
+	for _, exdate := range e.ExDates {
+		lw.time("EXDATE", exdate, e.Timezone, loc)
+	}
+
+	lw.line("SUMMARY", escapeText(e.Summary))
+
+	if len(e.Description) > 0 {
+		lw.line("DESCRIPTION", escapeText(e.Description))
+	}
+
+	if len(e.Location) > 0 {
+		lw.line("LOCATION", escapeText(e.Location))
+	}
+
+	if len(e.URL) > 0 {
+		lw.line("URL", e.URL)
+	}
+
+	lw.line("END", "VEVENT")
+
+	return nil
+}
+
+// lineWriter writes content lines, folding them at maxLineOctets and keeping the first error.
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (lw *lineWriter) line(name string, value string) {
+	if lw.err != nil {
+		return
+	}
+
+	line := name + ":" + value
+
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		// Fold at a rune boundary so multi-byte characters are not split.
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+
+		// Continuation lines lose one octet to their leading space.
+		limit = maxLineOctets - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+
+	_, lw.err = lw.w.WriteString(b.String())
+}
+
+// time writes a date time property in the event's timezone, or in UTC when it has none.
+func (lw *lineWriter) time(name string, t time.Time, timezone string, loc *time.Location) {
+	if len(timezone) == 0 {
+		lw.line(name, t.UTC().Format(utcDateTimeLayout))
+		return
+	}
+
+	lw.line(name+";TZID="+timezone, t.In(loc).Format(dateTimeLayout))
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+func unescapeText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+// property is a content line split into its name, parameters and value.
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func parseProperty(line string) (*property, error) {
+	// The value starts at the first colon outside of a quoted parameter value.
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return nil, fmt.Errorf("invalid content line %q", line)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	p := &property{
+		name:   strings.ToUpper(parts[0]),
+		params: map[string]string{},
+		value:  line[colon+1:],
+	}
+
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return p, nil
+}
+
+// Decode reads the VEVENTs of a VCALENDAR. Times with a TZID that is not an IANA timezone, such as the Windows
+// timezone names some calendars use, are read as UTC. RRULEs are kept as they are in Event.RRule.
+func Decode(r io.Reader) ([]*Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*Event
+	var event *Event
+	var duration time.Duration
+	depth := 0
+
+	for _, line := range lines {
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch p.name {
+		case "BEGIN":
+			if strings.EqualFold(p.value, "VEVENT") {
+				event = &Event{}
+				duration = 0
+				depth = 0
+			} else if event != nil {
+				depth++
+			}
+
+			continue
+		case "END":
+			if event == nil {
+				continue
+			}
+
+			if depth > 0 {
+				depth--
+				continue
+			}
+
+			if event.End.IsZero() {
+				event.End = event.Start.Add(duration)
+			}
+
+			events = append(events, event)
+			event = nil
+
+			continue
+		}
+
+		// Skip properties outside of events and inside their components, such as VALARM.
+		if event == nil || depth > 0 {
+			continue
+		}
+
+		switch p.name {
+		case "UID":
+			event.UID = unescapeText(p.value)
+		case "SUMMARY":
+			event.Summary = unescapeText(p.value)
+		case "DESCRIPTION":
+			event.Description = unescapeText(p.value)
+		case "LOCATION":
+			event.Location = unescapeText(p.value)
+		case "URL":
+			event.URL = p.value
+		case "DTSTART":
+			event.Start, err = parseTime(p)
+			if tzid, ok := p.params["TZID"]; ok && err == nil {
+				if _, loadErr := time.LoadLocation(tzid); loadErr == nil {
+					event.Timezone = tzid
+				}
+			}
+		case "DTEND":
+			event.End, err = parseTime(p)
+		case "DURATION":
+			duration, err = parseDuration(p.value)
+		case "RECURRENCE-ID":
+			event.RecurrenceID, err = parseTime(p)
+		case "EXDATE":
+			for _, value := range strings.Split(p.value, ",") {
+				var exdate time.Time
+				exdate, err = parseTime(&property{params: p.params, value: value})
+				if err != nil {
+					break
+				}
+
+				event.ExDates = append(event.ExDates, exdate)
+			}
+		case "RRULE":
+			event.RRule = p.value
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", p.name, err)
+		}
+	}
+
+	if event != nil {
+		return nil, errors.New("unterminated VEVENT")
+	}
+
+	return events, nil
+}
+
+// unfold reads content lines, joining folded lines back together.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) == 0 {
+			continue
+		}
+
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("reading lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+func parseTime(p *property) (time.Time, error) {
+	loc := time.UTC
+	if tzid, ok := p.params["TZID"]; ok {
+		l, err := time.LoadLocation(tzid)
+		if err == nil {
+			loc = l
+		}
+	}
+
+	if p.params["VALUE"] == "DATE" || len(p.value) == len(dateLayout) {
+		return time.ParseInLocation(dateLayout, p.value, loc)
+	}
+
+	if strings.HasSuffix(p.value, "Z") {
+		return time.Parse(utcDateTimeLayout, p.value)
+	}
+
+	return time.ParseInLocation(dateTimeLayout, p.value, loc)
+}
+
+// parseDuration parses the duration values calendars use for events, such as PT1H30M or P1D.
+func parseDuration(s string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+	}
+	s = strings.TrimLeft(s, "+-")
+
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour, 'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+
+	var d time.Duration
+	n := 0
+	digits := false
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == 'T':
+		case c >= '0' && c <= '9':
+			n = n*10 + int(c-'0')
+			digits = true
+		case slices.Contains([]byte("WDHMS"), c) && digits:
+			d += time.Duration(n) * units[c]
+			n = 0
+			digits = false
+		default:
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+	}
+
+	return sign * d, nil
+}
@@ -0,0 +1,238 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMeeting() *zoom.MeetingsCreateResponse {
+	return &zoom.MeetingsCreateResponse{
+		ID:        85746065432,
+		Topic:     "Weekly sync, team; all",
+		Agenda:    "Status updates",
+		CreatedAt: time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		Duration:  30,
+		JoinURL:   "https://zoom.us/j/85746065432",
+		Password:  "abc123",
+		StartTime: zoom.StartTime{Time: time.Date(2024, 1, 8, 14, 30, 0, 0, time.UTC)},
+		Timezone:  "America/New_York",
+		Type:      8,
+		Recurrence: &zoom.MeetingsCreateResponseRecurrance{
+			Type:           2,
+			RepeatInterval: 1,
+			WeeklyDays:     "2",
+			EndDateTime:    time.Date(2024, 3, 25, 13, 30, 0, 0, time.UTC),
+		},
+		Occurrences: []*zoom.MeetingsCreateResponseOccurances{
+			{StartTime: time.Date(2024, 1, 8, 14, 30, 0, 0, time.UTC), Duration: 30, Status: "available"},
+			{StartTime: time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC), Duration: 30, Status: "deleted"},
+			{StartTime: time.Date(2024, 1, 22, 14, 30, 0, 0, time.UTC), Duration: 60, Status: "available"},
+		},
+		Settings: &zoom.MeetingCreateResponseSettings{
+			GlobalDialInNumbers: []*zoom.MeetingsCreateResponseSettingsGlobalDialInNumber{
+				{City: "New York", CountryName: "US", Number: "+1 646 558 8656"},
+				{CountryName: "Germany", Number: "+49 69 7104 9922"},
+			},
+		},
+	}
+}
+
+func TestEncode(t *testing.T) {
+	assert := assert.New(t)
+
+	events, err := FromMeeting(testMeeting())
+	assert.NoError(err)
+	assert.Len(events, 2)
+
+	buf := &bytes.Buffer{}
+	err = Encode(buf, events)
+	assert.NoError(err)
+
+	out := buf.String()
+	for _, line := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"TZID:America/New_York\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20240310T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20241103T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n",
+		"UID:85746065432@zoom.us\r\n",
+		"DTSTAMP:20240102T120000Z\r\n",
+		"DTSTART;TZID=America/New_York:20240108T093000\r\n",
+		"DTEND;TZID=America/New_York:20240108T100000\r\n",
+		"RRULE:FREQ=WEEKLY;INTERVAL=1;BYDAY=MO;UNTIL=20240326T035959Z\r\n",
+		"EXDATE;TZID=America/New_York:20240115T093000\r\n",
+		"RECURRENCE-ID;TZID=America/New_York:20240122T093000\r\n",
+		"DTEND;TZID=America/New_York:20240122T103000\r\n",
+		"SUMMARY:Weekly sync\\, team\\; all\r\n",
+		"URL:https://zoom.us/j/85746065432\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		assert.Contains(out, line)
+	}
+
+	for _, line := range strings.Split(out, "\r\n") {
+		assert.LessOrEqual(len(line), maxLineOctets)
+	}
+
+	decoded, err := Decode(strings.NewReader(out))
+	assert.NoError(err)
+	assert.Len(decoded, 2)
+	assert.Contains(decoded[0].Description, "Meeting ID: 857 4606 5432\nPasscode: abc123\n\nDial by your location:\n  +1 646 558 8656 (New York, US)\n  +49 69 7104 9922 (Germany)")
+}
+
+func TestEncode_FromListItem(t *testing.T) {
+	assert := assert.New(t)
+
+	event := FromListItem(&zoom.MeetingsListItem{
+		ID:        1234,
+		Topic:     "Lunch",
+		JoinURL:   "https://zoom.us/j/1234",
+		StartTime: zoom.StartTime{Time: time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC)},
+		Duration:  45,
+	})
+
+	buf := &bytes.Buffer{}
+	err := Encode(buf, []*Event{event})
+
+	assert.NoError(err)
+	assert.NotContains(buf.String(), "VTIMEZONE")
+	assert.Contains(buf.String(), "DTSTART:20240108T120000Z\r\nDTEND:20240108T124500Z\r\n")
+	assert.Contains(buf.String(), "DESCRIPTION:Join Zoom meeting: https://zoom.us/j/1234\r\n")
+}
+
+func TestLineWriter_Fold(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := &bytes.Buffer{}
+	err := Encode(buf, []*Event{{
+		UID:         "1",
+		Summary:     strings.Repeat("é", 100),
+		Description: strings.Repeat("a", 200),
+		Start:       time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC),
+		End:         time.Date(2024, 1, 8, 13, 0, 0, 0, time.UTC),
+	}})
+	assert.NoError(err)
+
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		assert.LessOrEqual(len(line), maxLineOctets)
+		assert.True(strings.ToValidUTF8(line, "?") == line, line)
+	}
+
+	events, err := Decode(buf)
+	assert.NoError(err)
+	assert.Equal(strings.Repeat("é", 100), events[0].Summary)
+	assert.Equal(strings.Repeat("a", 200), events[0].Description)
+}
+
+func TestDecode_CreateOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	events, err := Decode(strings.NewReader(`BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:Europe/Berlin
+BEGIN:STANDARD
+DTSTART:19701025T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:invite-1
+SUMMARY:Planning
+DESCRIPTION:Quarterly planning\nBring notes
+DURATION:PT1H30M
+DTSTART;TZID=Europe/Berlin:20240105T100000
+RRULE:FREQ=MONTHLY;BYDAY=FR;BYSETPOS=1;COUNT=6
+BEGIN:VALARM
+ACTION:DISPLAY
+DESCRIPTION:Reminder
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:invite-2
+SUMMARY:Outlook meeting
+DTSTART;TZID="W. Europe Standard Time":20240105T100000
+DTEND;TZID="W. Europe Standard Time":20240105T103000
+END:VEVENT
+END:VCALENDAR
+`))
+	assert.NoError(err)
+	assert.Len(events, 2)
+
+	event := events[0]
+	assert.Equal("Quarterly planning\nBring notes", event.Description)
+	assert.Equal("Europe/Berlin", event.Timezone)
+	assert.Equal(time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC), event.Start.UTC())
+	assert.Equal(90*time.Minute, event.End.Sub(event.Start))
+	assert.Equal("FREQ=MONTHLY;BYDAY=FR;BYSETPOS=1;COUNT=6", event.RRule)
+
+	opts, err := event.CreateOptions()
+	assert.NoError(err)
+	assert.Equal("Planning", *opts.Topic)
+	assert.Equal(90, *opts.Duration)
+	assert.Equal("Europe/Berlin", *opts.Timezone)
+	assert.Equal(zoom.MeetingTypeRecurringFixed.Int(), *opts.Type)
+	assert.Equal(zoom.MonthlyWeekFirst.Int(), *opts.Recurrence.MonthlyWeek)
+	assert.Equal(6, *opts.Recurrence.MonthlyWeekDay)
+	assert.Equal(6, *opts.Recurrence.EndTimes)
+
+	// Windows timezone names are not IANA timezones, so the times are read as UTC.
+	opts, err = events[1].CreateOptions()
+	assert.NoError(err)
+	assert.Nil(opts.Timezone)
+	assert.Equal(zoom.MeetingTypeScheduled.Int(), *opts.Type)
+	assert.Equal(time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC), opts.StartTime.Time)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Decode(strings.NewReader("BEGIN:VEVENT\r\nUID:1\r\n"))
+	assert.ErrorContains(err, "unterminated VEVENT")
+
+	_, err = Decode(strings.NewReader("BEGIN:VEVENT\r\nDTSTART:2024\r\nEND:VEVENT\r\n"))
+	assert.ErrorContains(err, "parsing DTSTART")
+
+	// An RRULE Zoom cannot schedule fails only its own event's conversion.
+	events, err := Decode(strings.NewReader("BEGIN:VEVENT\r\nUID:1\r\nDTSTART:20240105T100000Z\r\nDURATION:PT1H\r\nRRULE:FREQ=YEARLY\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:2\r\nDTSTART:20240105T100000Z\r\nDURATION:PT1H\r\nEND:VEVENT\r\n"))
+	assert.NoError(err)
+	assert.Len(events, 2)
+	assert.Equal("FREQ=YEARLY", events[0].RRule)
+
+	_, err = events[0].CreateOptions()
+	assert.ErrorContains(err, "unsupported FREQ")
+
+	_, err = events[1].CreateOptions()
+	assert.NoError(err)
+
+	buf := &bytes.Buffer{}
+	err = Encode(buf, events[:1])
+	assert.NoError(err)
+	assert.Contains(buf.String(), "RRULE:FREQ=YEARLY\r\n")
+}
+
+func TestParseDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	for s, expected := range map[string]time.Duration{
+		"PT15M":     15 * time.Minute,
+		"PT1H30M":   90 * time.Minute,
+		"P1D":       24 * time.Hour,
+		"P1W":       7 * 24 * time.Hour,
+		"-PT5M":     -5 * time.Minute,
+		"P1DT2H10S": 26*time.Hour + 10*time.Second,
+	} {
+		d, err := parseDuration(s)
+		assert.NoError(err, s)
+		assert.Equal(expected, d, s)
+	}
+
+	_, err := parseDuration("1H")
+	assert.Error(err)
+}
@@ -0,0 +1,195 @@
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const (
+	occurrenceStatusDeleted = "deleted"
+
+	// Lengths Zoom accepts for a meeting's topic and agenda.
+	maxTopicLength  = 200
+	maxAgendaLength = 2000
+)
+
+// uid returns the UID of a meeting's event, stable across exports so calendars update the event instead of adding one.
+func uid(meetingID int64) string {
+	return strconv.FormatInt(meetingID, 10) + "@zoom.us"
+}
+
+// FromMeeting converts a meeting into an event, followed by an event for every occurrence of a recurring meeting whose
+// duration differs from the meeting's. Deleted occurrences are excluded from the recurrence.
+func FromMeeting(m *zoom.MeetingsCreateResponse) ([]*Event, error) {
+	event := &Event{
+		UID:         uid(m.ID),
+		Summary:     m.Topic,
+		Description: description(m),
+		Location:    m.JoinURL,
+		URL:         m.JoinURL,
+		Start:       m.StartTime.Time,
+		End:         m.StartTime.Add(time.Duration(m.Duration) * time.Minute),
+		Timezone:    m.Timezone,
+		Stamp:       m.CreatedAt,
+	}
+
+	if m.Recurrence == nil {
+		return []*Event{event}, nil
+	}
+
+	var err error
+	event.Recurrence, err = m.Recurrence.Recurrence()
+	if err != nil {
+		return nil, fmt.Errorf("converting recurrence: %w", err)
+	}
+
+	events := []*Event{event}
+
+	for i, occurrence := range m.Occurrences {
+		// Zoom reports the start of the next occurrence as the meeting's start, so start from the first occurrence.
+		if i == 0 && occurrence.StartTime.Before(event.Start) {
+			event.End = occurrence.StartTime.Add(event.End.Sub(event.Start))
+			event.Start = occurrence.StartTime
+		}
+
+		if occurrence.Status == occurrenceStatusDeleted {
+			event.ExDates = append(event.ExDates, occurrence.StartTime)
+			continue
+		}
+
+		if occurrence.Duration > 0 && occurrence.Duration != m.Duration {
+			override := *event
+			override.Recurrence = nil
+			override.ExDates = nil
+			override.RecurrenceID = occurrence.StartTime
+			override.Start = occurrence.StartTime
+			override.End = occurrence.StartTime.Add(time.Duration(occurrence.Duration) * time.Minute)
+
+			events = append(events, &override)
+		}
+	}
+
+	return events, nil
+}
+
+// FromListItem converts a meeting as listed by MeetingsService.List into an event. Listed meetings lack dial-in
+// numbers and recurrence, so the event only describes a single occurrence.
+func FromListItem(m *zoom.MeetingsListItem) *Event {
+	return &Event{
+		UID:         uid(m.ID),
+		Summary:     m.Topic,
+		Description: strings.TrimSpace(m.Agenda + "\n\nJoin Zoom meeting: " + m.JoinURL),
+		Location:    m.JoinURL,
+		URL:         m.JoinURL,
+		Start:       m.StartTime.Time,
+		End:         m.StartTime.Add(time.Duration(m.Duration) * time.Minute),
+		Timezone:    m.Timezone,
+		Stamp:       m.CreatedAt,
+	}
+}
+
+// description returns the meeting's agenda followed by how to join it, including its dial-in numbers.
+func description(m *zoom.MeetingsCreateResponse) string {
+	var b strings.Builder
+
+	if len(m.Agenda) > 0 {
+		b.WriteString(m.Agenda + "\n\n")
+	}
+
+	b.WriteString("Join Zoom meeting: " + m.JoinURL + "\n")
+	b.WriteString("Meeting ID: " + formatMeetingID(m.ID) + "\n")
+
+	if len(m.Password) > 0 {
+		b.WriteString("Passcode: " + m.Password + "\n")
+	}
+
+	if m.Settings != nil && len(m.Settings.GlobalDialInNumbers) > 0 {
+		b.WriteString("\nDial by your location:\n")
+
+		for _, number := range m.Settings.GlobalDialInNumbers {
+			location := number.CountryName
+			if len(number.City) > 0 {
+				location = number.City + ", " + location
+			}
+
+			b.WriteString("  " + number.Number + " (" + location + ")\n")
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// formatMeetingID groups a meeting ID's digits the way Zoom displays them, such as 123 4567 8901.
+func formatMeetingID(id int64) string {
+	s := strconv.FormatInt(id, 10)
+	if len(s) < 10 {
+		return s
+	}
+
+	return s[:3] + " " + s[3:len(s)-4] + " " + s[len(s)-4:]
+}
+
+// CreateOptions converts an event into the options to create a matching meeting. Events in a timezone supported by
+// Zoom keep it, others are scheduled in UTC, and recurring events become recurring meetings with a fixed time. It
+// fails for an RRule Zoom cannot schedule.
+func (e *Event) CreateOptions() (*zoom.MeetingsCreateOptions, error) {
+	if e.Start.IsZero() {
+		return nil, errors.New("event has no start")
+	}
+
+	duration := int(e.End.Sub(e.Start).Minutes())
+	if duration <= 0 {
+		return nil, errors.New("event must end after it starts")
+	}
+
+	opts := &zoom.MeetingsCreateOptions{
+		Duration:  zoom.Ptr(duration),
+		StartTime: zoom.NewStartTime(e.Start),
+		Topic:     zoom.Ptr(truncate(e.Summary, maxTopicLength)),
+		Type:      zoom.Ptr(zoom.MeetingTypeScheduled.Int()),
+	}
+
+	if len(e.Description) > 0 {
+		opts.Agenda = zoom.Ptr(truncate(e.Description, maxAgendaLength))
+	}
+
+	if zoom.ValidateTimezone(e.Timezone) == nil {
+		opts.StartTime.Timezone = e.Timezone
+		opts.Timezone = zoom.Ptr(e.Timezone)
+	}
+
+	var err error
+	recurrence := e.Recurrence
+	if recurrence == nil && len(e.RRule) > 0 {
+		recurrence, err = parseRRule(e.RRule, e.Start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if recurrence != nil {
+		opts.Recurrence, err = recurrence.Options()
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Type = zoom.Ptr(zoom.MeetingTypeRecurringFixed.Int())
+	}
+
+	return opts, nil
+}
+
+// truncate shortens s to at most n characters.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return string([]rune(s)[:n])
+}
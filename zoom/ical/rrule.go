@@ -0,0 +1,174 @@
+package ical
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// formatRRule converts a recurrence into an RRULE. Zoom ends recurrences on a date, so UNTIL is the end of that date
+// in the meeting's timezone.
+func formatRRule(r *zoom.Recurrence, loc *time.Location) (string, error) {
+	err := r.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+
+	switch r.Type {
+	case zoom.RecurrenceTypeDaily:
+		parts = append(parts, "FREQ=DAILY")
+	case zoom.RecurrenceTypeWeekly:
+		parts = append(parts, "FREQ=WEEKLY")
+	case zoom.RecurrenceTypeMonthly:
+		parts = append(parts, "FREQ=MONTHLY")
+	}
+
+	parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+
+	switch {
+	case r.Type == zoom.RecurrenceTypeWeekly:
+		weekdays := slices.Clone(r.Weekdays)
+		slices.Sort(weekdays)
+
+		codes := make([]string, len(weekdays))
+		for i, weekday := range weekdays {
+			codes[i] = weekdayCodes[weekday]
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	case r.Type == zoom.RecurrenceTypeMonthly && r.MonthlyWeek != 0:
+		parts = append(parts, "BYDAY="+strconv.Itoa(r.MonthlyWeek.Int())+weekdayCodes[r.MonthlyWeekday])
+	case r.Type == zoom.RecurrenceTypeMonthly:
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthlyDay))
+	}
+
+	if r.EndTimes > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.EndTimes))
+	}
+
+	if !r.EndDateTime.IsZero() {
+		end := r.EndDateTime.In(loc)
+		until := time.Date(end.Year(), end.Month(), end.Day(), 23, 59, 59, 0, loc)
+		parts = append(parts, "UNTIL="+until.UTC().Format(utcDateTimeLayout))
+	}
+
+	return strings.Join(parts, ";"), nil
+}
+
+// parseRRule converts the RRULEs Zoom can represent into a recurrence. Weekly and monthly rules without days recur on
+// the day of start, as they do in calendars.
+func parseRRule(s string, start time.Time) (*zoom.Recurrence, error) {
+	rule := map[string]string{}
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+
+		rule[strings.ToUpper(key)] = strings.ToUpper(value)
+	}
+
+	for key := range rule {
+		switch key {
+		case "FREQ", "INTERVAL", "BYDAY", "BYMONTHDAY", "BYSETPOS", "COUNT", "UNTIL", "WKST":
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %s", key)
+		}
+	}
+
+	interval := 1
+	if v, ok := rule["INTERVAL"]; ok {
+		var err error
+		interval, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing INTERVAL: %w", err)
+		}
+	}
+
+	var r *zoom.Recurrence
+
+	switch rule["FREQ"] {
+	case "DAILY":
+		r = zoom.DailyRecurrence(interval)
+	case "WEEKLY":
+		weekdays := []time.Weekday{start.Weekday()}
+		if v, ok := rule["BYDAY"]; ok {
+			weekdays = nil
+
+			for _, code := range strings.Split(v, ",") {
+				weekday := slices.Index(weekdayCodes, code)
+				if weekday < 0 {
+					return nil, fmt.Errorf("unsupported weekly BYDAY %q", code)
+				}
+
+				weekdays = append(weekdays, time.Weekday(weekday))
+			}
+		}
+
+		r = zoom.WeeklyRecurrence(interval, weekdays...)
+	case "MONTHLY":
+		byDay, hasByDay := rule["BYDAY"]
+		byMonthDay, hasByMonthDay := rule["BYMONTHDAY"]
+
+		switch {
+		case hasByDay:
+			// The week is either a prefix, such as -1FR, or in BYSETPOS, such as BYDAY=FR;BYSETPOS=-1.
+			weekday := slices.Index(weekdayCodes, byDay[max(len(byDay)-2, 0):])
+			weekValue := byDay[:max(len(byDay)-2, 0)]
+			if setPos, ok := rule["BYSETPOS"]; ok && len(weekValue) == 0 {
+				weekValue = setPos
+			}
+
+			week, err := strconv.Atoi(strings.TrimPrefix(weekValue, "+"))
+			if weekday < 0 || err != nil {
+				return nil, fmt.Errorf("unsupported monthly BYDAY %q", byDay)
+			}
+
+			r = zoom.MonthlyWeekdayRecurrence(interval, zoom.MonthlyWeek(week), time.Weekday(weekday))
+		case hasByMonthDay:
+			day, err := strconv.Atoi(byMonthDay)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported BYMONTHDAY %q", byMonthDay)
+			}
+
+			r = zoom.MonthlyDayRecurrence(interval, day)
+		default:
+			r = zoom.MonthlyDayRecurrence(interval, start.Day())
+		}
+	default:
+		return nil, fmt.Errorf("unsupported FREQ %q", rule["FREQ"])
+	}
+
+	if v, ok := rule["COUNT"]; ok {
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing COUNT: %w", err)
+		}
+
+		r.Times(count)
+	}
+
+	if v, ok := rule["UNTIL"]; ok {
+		until, err := parseTime(&property{value: v, params: map[string]string{}})
+		if err != nil {
+			return nil, fmt.Errorf("parsing UNTIL: %w", err)
+		}
+
+		r.Until(until)
+	}
+
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,66 @@
+package ical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRRule(t *testing.T) {
+	assert := assert.New(t)
+
+	// A Wednesday.
+	start := time.Date(2024, 1, 17, 9, 0, 0, 0, time.UTC)
+
+	for s, expected := range map[string]*zoom.Recurrence{
+		"FREQ=DAILY;INTERVAL=2;COUNT=5":      zoom.DailyRecurrence(2).Times(5),
+		"FREQ=WEEKLY":                        zoom.WeeklyRecurrence(1, time.Wednesday),
+		"FREQ=WEEKLY;BYDAY=MO,TH;WKST=SU":    zoom.WeeklyRecurrence(1, time.Monday, time.Thursday),
+		"FREQ=MONTHLY":                       zoom.MonthlyDayRecurrence(1, 17),
+		"FREQ=MONTHLY;BYMONTHDAY=3":          zoom.MonthlyDayRecurrence(1, 3),
+		"FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR": zoom.MonthlyWeekdayRecurrence(2, zoom.MonthlyWeekLast, time.Friday),
+		"FREQ=MONTHLY;BYDAY=+2TU":            zoom.MonthlyWeekdayRecurrence(1, zoom.MonthlyWeekSecond, time.Tuesday),
+		"freq=monthly;byday=we;bysetpos=3":   zoom.MonthlyWeekdayRecurrence(1, zoom.MonthlyWeekThird, time.Wednesday),
+		"FREQ=DAILY;UNTIL=20240131T235959Z":  zoom.DailyRecurrence(1).Until(time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC)),
+		"FREQ=DAILY;UNTIL=20240131":          zoom.DailyRecurrence(1).Until(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+	} {
+		r, err := parseRRule(s, start)
+		assert.NoError(err, s)
+		assert.Equal(expected, r, s)
+	}
+
+	for _, s := range []string{
+		"FREQ=YEARLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=DAILY;INTERVAL=100",
+		"FREQ=MONTHLY;BYDAY=FR",
+		"FREQ",
+	} {
+		_, err := parseRRule(s, start)
+		assert.Error(err, s)
+	}
+}
+
+func TestFormatRRule(t *testing.T) {
+	assert := assert.New(t)
+
+	loc, _ := time.LoadLocation("America/Los_Angeles")
+
+	rrule, err := formatRRule(zoom.WeeklyRecurrence(2, time.Friday, time.Monday).Times(4), loc)
+	assert.NoError(err)
+	assert.Equal("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4", rrule)
+
+	rrule, err = formatRRule(zoom.MonthlyWeekdayRecurrence(1, zoom.MonthlyWeekLast, time.Thursday).Until(time.Date(2024, 6, 30, 16, 0, 0, 0, time.UTC)), loc)
+	assert.NoError(err)
+	assert.Equal("FREQ=MONTHLY;INTERVAL=1;BYDAY=-1TH;UNTIL=20240701T065959Z", rrule)
+
+	rrule, err = formatRRule(zoom.MonthlyDayRecurrence(1, 15), loc)
+	assert.NoError(err)
+	assert.Equal("FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=15", rrule)
+
+	_, err = formatRRule(zoom.DailyRecurrence(0), loc)
+	assert.ErrorIs(err, zoom.ErrInvalidRecurrence)
+}
@@ -0,0 +1,81 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+)
+
+// writeTimezone writes a VTIMEZONE for loc covering the years from and to. Go does not expose a zone's rules, so the
+// observances are the zone's transitions found over those years rather than recurrence rules.
+func writeTimezone(lw *lineWriter, loc *time.Location, from int, to int) {
+	lw.line("BEGIN", "VTIMEZONE")
+	lw.line("TZID", loc.String())
+
+	start := time.Date(from, time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(to+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	// The observance in effect at the start of the range, as if it began then.
+	name, offset := start.Zone()
+	writeObservance(lw, start, start.IsDST(), name, offset, offset)
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+
+		_, before := day.Zone()
+		_, after := next.Zone()
+		if before == after {
+			continue
+		}
+
+		transition := findTransition(day, next)
+		name, offset := transition.Zone()
+		writeObservance(lw, transition, transition.IsDST(), name, before, offset)
+	}
+
+	lw.line("END", "VTIMEZONE")
+}
+
+// findTransition returns the first second after low at which the zone offset differs from low's, which must happen
+// before high.
+func findTransition(low time.Time, high time.Time) time.Time {
+	_, offset := low.Zone()
+
+	for high.Sub(low) > time.Second {
+		mid := low.Add(high.Sub(low) / 2).Truncate(time.Second)
+
+		_, midOffset := mid.Zone()
+		if midOffset == offset {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return high
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT observance. Its start is the local time of the onset in the offset in
+// effect before it.
+func writeObservance(lw *lineWriter, onset time.Time, dst bool, name string, from int, to int) {
+	component := "STANDARD"
+	if dst {
+		component = "DAYLIGHT"
+	}
+
+	lw.line("BEGIN", component)
+	lw.line("DTSTART", onset.UTC().Add(time.Duration(from)*time.Second).Format(dateTimeLayout))
+	lw.line("TZOFFSETFROM", formatOffset(from))
+	lw.line("TZOFFSETTO", formatOffset(to))
+	lw.line("TZNAME", name)
+	lw.line("END", component)
+}
+
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
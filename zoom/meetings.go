@@ -37,8 +37,32 @@ func (j JoinBeforeHostTime) Int() int {
 
 type MeetingsServicer interface {
 	List(ctx context.Context, userID string, opts *MeetingsListOptions) (*MeetingsListResponse, *http.Response, error)
+	Get(ctx context.Context, meetingID int64, opts *MeetingsGetOptions) (*MeetingsGetResponse, *http.Response, error)
 	Create(ctx context.Context, userID string, opts *MeetingsCreateOptions) (*MeetingsCreateResponse, *http.Response, error)
+	Update(ctx context.Context, meetingID int64, opts *MeetingsUpdateOptions) (*http.Response, error)
 	Delete(ctx context.Context, meetingID int64, opts *MeetingsDeleteOptions) (*http.Response, error)
+
+	ListRegistrants(ctx context.Context, meetingID int64, opts *RegistrantsListOptions) (*RegistrantsListResponse, *http.Response, error)
+	GetRegistrant(ctx context.Context, meetingID int64, registrantID string, opts *RegistrantsGetOptions) (*Registrant, *http.Response, error)
+	AddRegistrant(ctx context.Context, meetingID int64, opts *RegistrantsAddOptions) (*RegistrantsAddResponse, *http.Response, error)
+	BatchAddRegistrants(ctx context.Context, meetingID int64, opts *RegistrantsBatchAddOptions) (*RegistrantsBatchAddResponse, *http.Response, error)
+	UpdateRegistrantsStatus(ctx context.Context, meetingID int64, opts *RegistrantsUpdateStatusOptions) (*http.Response, error)
+	DeleteRegistrant(ctx context.Context, meetingID int64, registrantID string, opts *RegistrantsDeleteOptions) (*http.Response, error)
+	GetRegistrationQuestions(ctx context.Context, meetingID int64) (*RegistrationQuestions, *http.Response, error)
+	UpdateRegistrationQuestions(ctx context.Context, meetingID int64, opts *RegistrationQuestions) (*http.Response, error)
+
+	ListPolls(ctx context.Context, meetingID int64) (*PollsListResponse, *http.Response, error)
+	GetPoll(ctx context.Context, meetingID int64, pollID string) (*Poll, *http.Response, error)
+	CreatePoll(ctx context.Context, meetingID int64, opts *PollsCreateOptions) (*Poll, *http.Response, error)
+	UpdatePoll(ctx context.Context, meetingID int64, pollID string, opts *PollsUpdateOptions) (*http.Response, error)
+	DeletePoll(ctx context.Context, meetingID int64, pollID string) (*http.Response, error)
+	GetSurvey(ctx context.Context, meetingID int64) (*MeetingsSurvey, *http.Response, error)
+	UpdateSurvey(ctx context.Context, meetingID int64, opts *MeetingsSurvey) (*http.Response, error)
+	DeleteSurvey(ctx context.Context, meetingID int64) (*http.Response, error)
+
+	CreateInviteLinks(ctx context.Context, meetingID int64, opts *MeetingsCreateInviteLinksOptions) (*MeetingsCreateInviteLinksResponse, *http.Response, error)
+	UpdateLiveMeeting(ctx context.Context, meetingID int64, opts *MeetingsUpdateLiveMeetingOptions) (*http.Response, error)
+	SIPDialing(ctx context.Context, meetingID int64, opts *MeetingsSIPDialingOptions) (*MeetingsSIPDialingResponse, *http.Response, error)
 }
 
 type MeetingsService struct {
@@ -67,7 +91,7 @@ type MeetingsListItem struct {
 	ID        int64     `json:"id"`
 	JoinURL   string    `json:"join_url"`
 	Pmi       string    `json:"pmi"`
-	StartTime time.Time `json:"start_time"`
+	StartTime StartTime `json:"start_time"`
 	Timezone  string    `json:"timezone"`
 	Topic     string    `json:"topic"`
 	Type      int       `json:"type"`
@@ -86,28 +110,59 @@ func (m *MeetingsService) List(ctx context.Context, userID string, opts *Meeting
 }
 
 type MeetingsCreateOptions struct {
-	DefaultPassword *bool                           `json:"default_password,omitempty"`
-	Duration        *int                            `json:"duration,omitempty"`
-	Settings        *MeetingsCreateOptionsSettings  `json:"settings,omitempty"`
-	StartTime       *MeetingsCreateOptionsStartTime `json:"start_time,omitempty"`
-	Type            *int                            `json:"type,omitempty"`
+	Agenda          *string                                `json:"agenda,omitempty"`
+	DefaultPassword *bool                                  `json:"default_password,omitempty"`
+	Duration        *int                                   `json:"duration,omitempty"`
+	Password        *string                                `json:"password,omitempty"`
+	Recurrence      *MeetingsCreateOptionsRecurrence       `json:"recurrence,omitempty"`
+	ScheduleFor     *string                                `json:"schedule_for,omitempty"`
+	Settings        *MeetingsCreateOptionsSettings         `json:"settings,omitempty"`
+	StartTime       *StartTime                             `json:"start_time,omitempty"`
+	Timezone        *string                                `json:"timezone,omitempty"`
+	Topic           *string                                `json:"topic,omitempty"`
+	TrackingFields  []*MeetingsCreateResponseTrackingField `json:"tracking_fields,omitempty"`
+	Type            *int                                   `json:"type,omitempty"`
 }
 
-type MeetingsCreateOptionsStartTime time.Time
+// MarshalJSON sets the timezone to the start time's when it is sent as a wall-clock time, failing when the two differ.
+func (m *MeetingsCreateOptions) MarshalJSON() ([]byte, error) {
+	// A nil options embedded in MeetingsUpdateOptions updates nothing.
+	if m == nil {
+		return []byte("{}"), nil
+	}
+
+	type alias MeetingsCreateOptions
+	opts := alias(*m)
 
-func (m MeetingsCreateOptionsStartTime) MarshalJSON() ([]byte, error) {
-	format := time.Time(m).UTC().Format("2006-01-02T15:04:00Z")
-	b, err := json.Marshal(format)
+	var err error
+	opts.Timezone, err = startTimeTimezone(m.StartTime, m.Timezone)
 	if err != nil {
 		return nil, err
 	}
 
-	return b, nil
+	return json.Marshal(&opts)
+}
+
+type MeetingsCreateOptionsRecurrence struct {
+	EndDateTime    *time.Time `json:"end_date_time,omitempty"`
+	EndTimes       *int       `json:"end_times,omitempty"`
+	MonthlyDay     *int       `json:"monthly_day,omitempty"`
+	MonthlyWeek    *int       `json:"monthly_week,omitempty"`
+	MonthlyWeekDay *int       `json:"monthly_week_day,omitempty"`
+	RepeatInterval *int       `json:"repeat_interval,omitempty"`
+	Type           int        `json:"type"`
+	WeeklyDays     *string    `json:"weekly_days,omitempty"`
 }
 
 type MeetingsCreateOptionsSettings struct {
-	JBHTime        *int  `json:"jbh_time,omitempty"`
-	JoinBeforeHost *bool `json:"join_before_host,omitempty"`
+	AlternativeHosts *string `json:"alternative_hosts,omitempty"`
+	AutoRecording    *string `json:"auto_recording,omitempty"`
+	HostVideo        *bool   `json:"host_video,omitempty"`
+	JBHTime          *int    `json:"jbh_time,omitempty"`
+	JoinBeforeHost   *bool   `json:"join_before_host,omitempty"`
+	MuteUponEntry    *bool   `json:"mute_upon_entry,omitempty"`
+	ParticipantVideo *bool   `json:"participant_video,omitempty"`
+	WaitingRoom      *bool   `json:"waiting_room,omitempty"`
 }
 
 type MeetingsCreateResponseOccurances struct {
@@ -231,14 +286,14 @@ type MeetingsCreateResponse struct {
 	HostEmail       string                                 `json:"host_email"`
 	ID              int64                                  `json:"id"`
 	JoinURL         string                                 `json:"join_url"`
-	Occurrences     *MeetingsCreateResponseOccurances      `json:"occurrences"`
+	Occurrences     []*MeetingsCreateResponseOccurances    `json:"occurrences"`
 	Password        string                                 `json:"password"`
 	Pmi             string                                 `json:"pmi"`
 	PreSchedule     bool                                   `json:"pre_schedule"`
 	Recurrence      *MeetingsCreateResponseRecurrance      `json:"recurrence"`
 	RegistrationURL string                                 `json:"registration_url"`
 	Settings        *MeetingCreateResponseSettings         `json:"settings"`
-	StartTime       time.Time                              `json:"start_time"`
+	StartTime       StartTime                              `json:"start_time"`
 	StartURL        string                                 `json:"start_url"`
 	Timezone        string                                 `json:"timezone"`
 	Topic           string                                 `json:"topic"`
@@ -257,6 +312,43 @@ func (m *MeetingsService) Create(ctx context.Context, userID string, opts *Meeti
 	return out, res, nil
 }
 
+type MeetingsGetOptions struct {
+	OccurrenceID            *string `url:"occurrence_id,omitempty"`
+	ShowPreviousOccurrences *bool   `url:"show_previous_occurrences,omitempty"`
+}
+
+type MeetingsGetResponse struct {
+	MeetingsCreateResponse
+
+	Status string `json:"status"`
+}
+
+func (m *MeetingsService) Get(ctx context.Context, meetingID int64, opts *MeetingsGetOptions) (*MeetingsGetResponse, *http.Response, error) {
+	out := &MeetingsGetResponse{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type MeetingsUpdateOptions struct {
+	*MeetingsCreateOptions `url:"-"`
+
+	OccurrenceID *string `url:"occurrence_id,omitempty" json:"-"`
+}
+
+func (m *MeetingsService) Update(ctx context.Context, meetingID int64, opts *MeetingsUpdateOptions) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodPatch, meetingPath(meetingID), opts, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
 type MeetingsDeleteOptions struct {
 	OccurrenceID          *string `url:"occurrence_id,omitempty"`
 	ScheduleForReminder   *bool   `url:"schedule_for_reminder,omitempty"`
@@ -0,0 +1,137 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	LiveMeetingEventRecordingStart                     LiveMeetingEventMethod = "recording.start"
+	LiveMeetingEventRecordingStop                      LiveMeetingEventMethod = "recording.stop"
+	LiveMeetingEventRecordingPause                     LiveMeetingEventMethod = "recording.pause"
+	LiveMeetingEventRecordingResume                    LiveMeetingEventMethod = "recording.resume"
+	LiveMeetingEventParticipantInvite                  LiveMeetingEventMethod = "participant.invite"
+	LiveMeetingEventParticipantInviteCallout           LiveMeetingEventMethod = "participant.invite.callout"
+	LiveMeetingEventParticipantInviteRoomSystemCallout LiveMeetingEventMethod = "participant.invite.room_system_callout"
+)
+
+// LiveMeetingEventMethod is the in-meeting action performed by MeetingsService.UpdateLiveMeeting.
+type LiveMeetingEventMethod string
+
+func (l LiveMeetingEventMethod) String() string {
+	return string(l)
+}
+
+type MeetingsCreateInviteLinksOptions struct {
+	Attendees []*MeetingsCreateInviteLinksOptionsAttendee `json:"attendees"`
+	// TTL is how long, in seconds, the links are valid for.
+	TTL *int `json:"ttl,omitempty"`
+}
+
+type MeetingsCreateInviteLinksOptionsAttendee struct {
+	Name string `json:"name"`
+}
+
+type MeetingsCreateInviteLinksResponse struct {
+	Attendees []*MeetingsCreateInviteLinksResponseAttendee `json:"attendees"`
+}
+
+type MeetingsCreateInviteLinksResponseAttendee struct {
+	JoinURL string `json:"join_url"`
+	Name    string `json:"name"`
+}
+
+func (m *MeetingsService) CreateInviteLinks(ctx context.Context, meetingID int64, opts *MeetingsCreateInviteLinksOptions) (*MeetingsCreateInviteLinksResponse, *http.Response, error) {
+	out := &MeetingsCreateInviteLinksResponse{}
+
+	res, err := m.client.request(ctx, http.MethodPost, meetingPath(meetingID)+"/invite_links", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type MeetingsSIPDialingOptions struct {
+	// Passcode is required when the meeting has one and it is not embedded in the SIP URI.
+	Passcode *string `json:"passcode,omitempty"`
+}
+
+type MeetingsSIPDialingResponse struct {
+	// ExpireIn is how many seconds the SIP URI is valid for.
+	ExpireIn int `json:"expire_in"`
+	// PaidCRCPlanParticipant is whether the account has a Cloud Room Connector plan to join with.
+	PaidCRCPlanParticipant    bool   `json:"paid_crc_plan_participant"`
+	ParticipantIdentifierCode string `json:"participant_identifier_code"`
+	SIPDialing                string `json:"sip_dialing"`
+}
+
+// SIPDialing returns the SIP URI a room system dials to join a meeting.
+func (m *MeetingsService) SIPDialing(ctx context.Context, meetingID int64, opts *MeetingsSIPDialingOptions) (*MeetingsSIPDialingResponse, *http.Response, error) {
+	out := &MeetingsSIPDialingResponse{}
+
+	res, err := m.client.request(ctx, http.MethodPost, meetingPath(meetingID)+"/sip_dialing", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type MeetingsUpdateLiveMeetingOptions struct {
+	Method LiveMeetingEventMethod                  `json:"method"`
+	Params *MeetingsUpdateLiveMeetingOptionsParams `json:"params,omitempty"`
+}
+
+// MeetingsUpdateLiveMeetingOptionsParams holds the parameters for the participant invite methods. Recording methods take no parameters.
+type MeetingsUpdateLiveMeetingOptionsParams struct {
+	CallType      *string                                              `json:"call_type,omitempty"`
+	Contacts      []*MeetingsUpdateLiveMeetingOptionsParamsContact     `json:"contacts,omitempty"`
+	DeviceIP      *string                                              `json:"device_ip,omitempty"`
+	H323Headers   *MeetingsUpdateLiveMeetingOptionsParamsH323Headers   `json:"h323_headers,omitempty"`
+	InviteeName   *string                                              `json:"invitee_name,omitempty"`
+	InviteOptions *MeetingsUpdateLiveMeetingOptionsParamsInviteOptions `json:"invite_options,omitempty"`
+	PhoneNumber   *string                                              `json:"phone_number,omitempty"`
+	SIPHeaders    *MeetingsUpdateLiveMeetingOptionsParamsSIPHeaders    `json:"sip_headers,omitempty"`
+}
+
+// MeetingsUpdateLiveMeetingOptionsParamsContact identifies a Zoom user invited to the meeting with a chat invitation.
+type MeetingsUpdateLiveMeetingOptionsParamsContact struct {
+	Email *string `json:"email,omitempty"`
+	ID    *string `json:"id,omitempty"`
+}
+
+type MeetingsUpdateLiveMeetingOptionsParamsH323Headers struct {
+	FromDisplayName *string `json:"from_display_name,omitempty"`
+	ToDisplayName   *string `json:"to_display_name,omitempty"`
+}
+
+type MeetingsUpdateLiveMeetingOptionsParamsInviteOptions struct {
+	RequireGreeting    *bool `json:"require_greeting,omitempty"`
+	RequirePressingOne *bool `json:"require_pressing_one,omitempty"`
+}
+
+type MeetingsUpdateLiveMeetingOptionsParamsSIPHeaders struct {
+	AdditionalHeaders []*MeetingsUpdateLiveMeetingOptionsParamsSIPHeader `json:"additional_headers,omitempty"`
+	FromDisplayName   *string                                            `json:"from_display_name,omitempty"`
+	FromURI           *string                                            `json:"from_uri,omitempty"`
+	ToDisplayName     *string                                            `json:"to_display_name,omitempty"`
+}
+
+type MeetingsUpdateLiveMeetingOptionsParamsSIPHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// UpdateLiveMeeting performs an in-meeting action such as starting or stopping the cloud recording or inviting participants.
+func (m *MeetingsService) UpdateLiveMeeting(ctx context.Context, meetingID int64, opts *MeetingsUpdateLiveMeetingOptions) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodPatch, "/live_meetings/"+url.QueryEscape(strconv.FormatInt(meetingID, 10))+"/events", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,105 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (m *MeetingsService) ListPolls(ctx context.Context, meetingID int64) (*PollsListResponse, *http.Response, error) {
+	out := &PollsListResponse{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID)+"/polls", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) GetPoll(ctx context.Context, meetingID int64, pollID string) (*Poll, *http.Response, error) {
+	out := &Poll{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID)+"/polls/"+url.QueryEscape(pollID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) CreatePoll(ctx context.Context, meetingID int64, opts *PollsCreateOptions) (*Poll, *http.Response, error) {
+	out := &Poll{}
+
+	res, err := m.client.request(ctx, http.MethodPost, meetingPath(meetingID)+"/polls", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) UpdatePoll(ctx context.Context, meetingID int64, pollID string, opts *PollsUpdateOptions) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodPut, meetingPath(meetingID)+"/polls/"+url.QueryEscape(pollID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (m *MeetingsService) DeletePoll(ctx context.Context, meetingID int64, pollID string) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodDelete, meetingPath(meetingID)+"/polls/"+url.QueryEscape(pollID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+// MeetingsSurvey is used both to read and to update a meeting's post-meeting survey.
+type MeetingsSurvey struct {
+	CustomSurvey     *MeetingsSurveyCustomSurvey `json:"custom_survey,omitempty"`
+	ShowInTheBrowser *bool                       `json:"show_in_the_browser,omitempty"`
+	ThirdPartySurvey *string                     `json:"third_party_survey,omitempty"`
+}
+
+// MeetingsSurveyCustomSurvey questions share their schema with poll questions.
+type MeetingsSurveyCustomSurvey struct {
+	Anonymous         bool            `json:"anonymous,omitempty"`
+	Feedback          string          `json:"feedback,omitempty"`
+	NumberedQuestions bool            `json:"numbered_questions,omitempty"`
+	Questions         []*PollQuestion `json:"questions,omitempty"`
+	ShowQuestionType  bool            `json:"show_question_type,omitempty"`
+	Title             string          `json:"title,omitempty"`
+}
+
+func (m *MeetingsService) GetSurvey(ctx context.Context, meetingID int64) (*MeetingsSurvey, *http.Response, error) {
+	out := &MeetingsSurvey{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID)+"/survey", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) UpdateSurvey(ctx context.Context, meetingID int64, opts *MeetingsSurvey) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodPatch, meetingPath(meetingID)+"/survey", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (m *MeetingsService) DeleteSurvey(ctx context.Context, meetingID int64) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodDelete, meetingPath(meetingID)+"/survey", nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,280 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	RegistrantStatusApproved = "approved"
+	RegistrantStatusPending  = "pending"
+	RegistrantStatusDenied   = "denied"
+
+	RegistrantsStatusActionApprove RegistrantsStatusAction = "approve"
+	RegistrantsStatusActionDeny    RegistrantsStatusAction = "deny"
+	RegistrantsStatusActionCancel  RegistrantsStatusAction = "cancel"
+)
+
+// RegistrantsStatusAction is the action taken when updating the status of one or more registrants.
+type RegistrantsStatusAction string
+
+func (r RegistrantsStatusAction) String() string {
+	return string(r)
+}
+
+type RegistrantsListOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	OccurrenceID *string `url:"occurrence_id,omitempty"`
+	Status       *string `url:"status,omitempty"`
+}
+
+type RegistrantsListResponse struct {
+	*PaginationResponse
+
+	Registrants []*Registrant `json:"registrants"`
+}
+
+type Registrant struct {
+	Address               string                      `json:"address"`
+	City                  string                      `json:"city"`
+	Comments              string                      `json:"comments"`
+	Country               string                      `json:"country"`
+	CreateTime            time.Time                   `json:"create_time"`
+	CustomQuestions       []*RegistrantCustomQuestion `json:"custom_questions"`
+	Email                 string                      `json:"email"`
+	FirstName             string                      `json:"first_name"`
+	ID                    string                      `json:"id"`
+	Industry              string                      `json:"industry"`
+	JobTitle              string                      `json:"job_title"`
+	JoinURL               string                      `json:"join_url"`
+	LastName              string                      `json:"last_name"`
+	NoOfEmployees         string                      `json:"no_of_employees"`
+	Org                   string                      `json:"org"`
+	Phone                 string                      `json:"phone"`
+	PurchasingTimeFrame   string                      `json:"purchasing_time_frame"`
+	RoleInPurchaseProcess string                      `json:"role_in_purchase_process"`
+	State                 string                      `json:"state"`
+	Status                string                      `json:"status"`
+	Zip                   string                      `json:"zip"`
+}
+
+type RegistrantCustomQuestion struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type RegistrantsAddOptions struct {
+	OccurrenceIDs *string `url:"occurrence_ids,omitempty" json:"-"`
+
+	Address               *string                     `url:"-" json:"address,omitempty"`
+	AutoApprove           *bool                       `url:"-" json:"auto_approve,omitempty"`
+	City                  *string                     `url:"-" json:"city,omitempty"`
+	Comments              *string                     `url:"-" json:"comments,omitempty"`
+	Country               *string                     `url:"-" json:"country,omitempty"`
+	CustomQuestions       []*RegistrantCustomQuestion `url:"-" json:"custom_questions,omitempty"`
+	Email                 string                      `url:"-" json:"email"`
+	FirstName             string                      `url:"-" json:"first_name"`
+	Industry              *string                     `url:"-" json:"industry,omitempty"`
+	JobTitle              *string                     `url:"-" json:"job_title,omitempty"`
+	Language              *string                     `url:"-" json:"language,omitempty"`
+	LastName              *string                     `url:"-" json:"last_name,omitempty"`
+	NoOfEmployees         *string                     `url:"-" json:"no_of_employees,omitempty"`
+	Org                   *string                     `url:"-" json:"org,omitempty"`
+	Phone                 *string                     `url:"-" json:"phone,omitempty"`
+	PurchasingTimeFrame   *string                     `url:"-" json:"purchasing_time_frame,omitempty"`
+	RoleInPurchaseProcess *string                     `url:"-" json:"role_in_purchase_process,omitempty"`
+	State                 *string                     `url:"-" json:"state,omitempty"`
+	Zip                   *string                     `url:"-" json:"zip,omitempty"`
+}
+
+type RegistrantsAddResponse struct {
+	ID                 int64                               `json:"id"`
+	JoinURL            string                              `json:"join_url"`
+	Occurrences        []*MeetingsCreateResponseOccurances `json:"occurrences"`
+	ParticipantPinCode int64                               `json:"participant_pin_code"`
+	RegistrantID       string                              `json:"registrant_id"`
+	StartTime          time.Time                           `json:"start_time"`
+	Topic              string                              `json:"topic"`
+}
+
+type RegistrantsBatchAddOptions struct {
+	AutoApprove                  *bool                                   `json:"auto_approve,omitempty"`
+	RegistrantsConfirmationEmail *bool                                   `json:"registrants_confirmation_email,omitempty"`
+	Registrants                  []*RegistrantsBatchAddOptionsRegistrant `json:"registrants"`
+}
+
+type RegistrantsBatchAddOptionsRegistrant struct {
+	Email     string  `json:"email"`
+	FirstName string  `json:"first_name"`
+	LastName  *string `json:"last_name,omitempty"`
+}
+
+type RegistrantsBatchAddResponse struct {
+	Registrants []*RegistrantsBatchAddResponseRegistrant `json:"registrants"`
+}
+
+type RegistrantsBatchAddResponseRegistrant struct {
+	Email              string `json:"email"`
+	JoinURL            string `json:"join_url"`
+	ParticipantPinCode int64  `json:"participant_pin_code"`
+	RegistrantID       string `json:"registrant_id"`
+}
+
+type RegistrantsUpdateStatusOptions struct {
+	OccurrenceID *string `url:"occurrence_id,omitempty" json:"-"`
+
+	Action      RegistrantsStatusAction                     `url:"-" json:"action"`
+	Registrants []*RegistrantsUpdateStatusOptionsRegistrant `url:"-" json:"registrants,omitempty"`
+}
+
+type RegistrantsUpdateStatusOptionsRegistrant struct {
+	Email *string `json:"email,omitempty"`
+	ID    *string `json:"id,omitempty"`
+}
+
+type RegistrantsDeleteOptions struct {
+	OccurrenceID *string `url:"occurrence_id,omitempty"`
+}
+
+type RegistrantsGetOptions struct {
+	OccurrenceID *string `url:"occurrence_id,omitempty"`
+}
+
+// RegistrationQuestions is used both to read and to update the questions asked when registering. An update replaces
+// both lists, so a nil or empty list removes all of its questions.
+type RegistrationQuestions struct {
+	CustomQuestions []*RegistrationQuestionsCustomQuestion `json:"custom_questions,omitempty"`
+	Questions       []*RegistrationQuestionsQuestion       `json:"questions,omitempty"`
+}
+
+// registrationQuestionsBody always sends both lists, so updating with an empty list removes all of its questions.
+type registrationQuestionsBody struct {
+	CustomQuestions []*RegistrationQuestionsCustomQuestion `json:"custom_questions"`
+	Questions       []*RegistrationQuestionsQuestion       `json:"questions"`
+}
+
+func (r *RegistrationQuestions) body() *registrationQuestionsBody {
+	body := &registrationQuestionsBody{
+		CustomQuestions: []*RegistrationQuestionsCustomQuestion{},
+		Questions:       []*RegistrationQuestionsQuestion{},
+	}
+
+	if r == nil {
+		return body
+	}
+
+	if r.CustomQuestions != nil {
+		body.CustomQuestions = r.CustomQuestions
+	}
+
+	if r.Questions != nil {
+		body.Questions = r.Questions
+	}
+
+	return body
+}
+
+type RegistrationQuestionsCustomQuestion struct {
+	Answers  []string `json:"answers,omitempty"`
+	Required bool     `json:"required"`
+	Title    string   `json:"title"`
+	Type     string   `json:"type"`
+}
+
+type RegistrationQuestionsQuestion struct {
+	FieldName string `json:"field_name"`
+	Required  bool   `json:"required"`
+}
+
+func meetingPath(meetingID int64) string {
+	return "/meetings/" + url.QueryEscape(strconv.FormatInt(meetingID, 10))
+}
+
+func (m *MeetingsService) ListRegistrants(ctx context.Context, meetingID int64, opts *RegistrantsListOptions) (*RegistrantsListResponse, *http.Response, error) {
+	out := &RegistrantsListResponse{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID)+"/registrants", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) GetRegistrant(ctx context.Context, meetingID int64, registrantID string, opts *RegistrantsGetOptions) (*Registrant, *http.Response, error) {
+	out := &Registrant{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID)+"/registrants/"+url.QueryEscape(registrantID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// AddRegistrant registers a participant for a meeting. The returned JoinURL is unique to the registrant.
+func (m *MeetingsService) AddRegistrant(ctx context.Context, meetingID int64, opts *RegistrantsAddOptions) (*RegistrantsAddResponse, *http.Response, error) {
+	out := &RegistrantsAddResponse{}
+
+	res, err := m.client.request(ctx, http.MethodPost, meetingPath(meetingID)+"/registrants", opts, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// BatchAddRegistrants registers up to 30 participants for a meeting in a single request.
+func (m *MeetingsService) BatchAddRegistrants(ctx context.Context, meetingID int64, opts *RegistrantsBatchAddOptions) (*RegistrantsBatchAddResponse, *http.Response, error) {
+	out := &RegistrantsBatchAddResponse{}
+
+	res, err := m.client.request(ctx, http.MethodPost, meetingPath(meetingID)+"/batch_registrants", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) UpdateRegistrantsStatus(ctx context.Context, meetingID int64, opts *RegistrantsUpdateStatusOptions) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodPut, meetingPath(meetingID)+"/registrants/status", opts, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (m *MeetingsService) DeleteRegistrant(ctx context.Context, meetingID int64, registrantID string, opts *RegistrantsDeleteOptions) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodDelete, meetingPath(meetingID)+"/registrants/"+url.QueryEscape(registrantID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (m *MeetingsService) GetRegistrationQuestions(ctx context.Context, meetingID int64) (*RegistrationQuestions, *http.Response, error) {
+	out := &RegistrationQuestions{}
+
+	res, err := m.client.request(ctx, http.MethodGet, meetingPath(meetingID)+"/registrants/questions", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (m *MeetingsService) UpdateRegistrationQuestions(ctx context.Context, meetingID int64, opts *RegistrationQuestions) (*http.Response, error) {
+	res, err := m.client.request(ctx, http.MethodPatch, meetingPath(meetingID)+"/registrants/questions", nil, opts.body(), nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,63 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// listAll calls fn once per page, following next_page_token until Zoom reports no further pages.
+// fn is expected to make a single request with the given pagination options and collect its results.
+func listAll(ctx context.Context, opts *PaginationOptions, fn func(*PaginationOptions) (*PaginationResponse, error)) error {
+	page := &PaginationOptions{}
+	if opts != nil {
+		*page = *opts
+	}
+
+	for {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		pagination, err := fn(page)
+		if err != nil {
+			return err
+		}
+
+		if pagination == nil || len(pagination.NextPageToken) == 0 {
+			return nil
+		}
+
+		page.NextPageToken = Ptr(pagination.NextPageToken)
+	}
+}
+
+// listAllInRange splits from and to into windows of at most maxDays days and calls fn for every page of every window,
+// collecting the items it returns.
+func listAllInRange[T any](ctx context.Context, from, to time.Time, maxDays int, opts *PaginationOptions, fn func(DateRange, *PaginationOptions) ([]T, *PaginationResponse, error)) ([]T, error) {
+	var items []T
+
+	for _, dr := range SplitDateRange(from, to, maxDays) {
+		var pagination *PaginationOptions
+		if opts != nil {
+			pagination = &PaginationOptions{PageSize: opts.PageSize}
+		}
+
+		err := listAll(ctx, pagination, func(page *PaginationOptions) (*PaginationResponse, error) {
+			pageItems, res, err := fn(dr, page)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, pageItems...)
+
+			return res, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing from %s to %s: %w", dr.From.Format("2006-01-02"), dr.To.Format("2006-01-02"), err)
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,77 @@
+package zoom
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAll(t *testing.T) {
+	assert := assert.New(t)
+
+	tokens := []string{"a", "b", ""}
+	var seen []*string
+
+	err := listAll(context.Background(), &PaginationOptions{PageSize: Ptr(10)}, func(opts *PaginationOptions) (*PaginationResponse, error) {
+		assert.Equal(10, *opts.PageSize)
+		seen = append(seen, opts.NextPageToken)
+
+		return &PaginationResponse{NextPageToken: tokens[len(seen)-1]}, nil
+	})
+
+	assert.NoError(err)
+	assert.Equal([]*string{nil, Ptr("a"), Ptr("b")}, seen)
+}
+
+func TestListAll_Error(t *testing.T) {
+	assert := assert.New(t)
+
+	expectedErr := errors.New("foo")
+	calls := 0
+
+	err := listAll(context.Background(), nil, func(opts *PaginationOptions) (*PaginationResponse, error) {
+		calls++
+		return nil, expectedErr
+	})
+
+	assert.ErrorIs(err, expectedErr)
+	assert.Equal(1, calls)
+}
+
+func TestListAll_ContextCanceled(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := listAll(ctx, nil, func(opts *PaginationOptions) (*PaginationResponse, error) {
+		return &PaginationResponse{NextPageToken: "a"}, nil
+	})
+
+	assert.ErrorIs(err, context.Canceled)
+}
+
+func TestListAllInRange(t *testing.T) {
+	assert := assert.New(t)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	var ranges []DateRange
+
+	items, err := listAllInRange(context.Background(), from, to, 30, nil, func(dr DateRange, page *PaginationOptions) ([]string, *PaginationResponse, error) {
+		if page.NextPageToken == nil {
+			ranges = append(ranges, dr)
+			return []string{dr.From.Format("2006-01-02") + "/1"}, &PaginationResponse{NextPageToken: "next"}, nil
+		}
+
+		return []string{dr.From.Format("2006-01-02") + "/2"}, &PaginationResponse{}, nil
+	})
+
+	assert.NoError(err)
+	assert.Equal([]string{"2024-01-01/1", "2024-01-01/2", "2024-01-31/1", "2024-01-31/2"}, items)
+	assert.Len(ranges, 2)
+}
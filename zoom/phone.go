@@ -0,0 +1,521 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	PhoneCallLogTypeAll    PhoneCallLogType = "all"
+	PhoneCallLogTypeMissed PhoneCallLogType = "missed"
+
+	PhoneVoicemailStatusAll    PhoneVoicemailStatus = "all"
+	PhoneVoicemailStatusRead   PhoneVoicemailStatus = "read"
+	PhoneVoicemailStatusUnread PhoneVoicemailStatus = "unread"
+
+	// phoneMaxRangeDays is the longest from/to range accepted when listing call logs or recordings.
+	phoneMaxRangeDays = 30
+)
+
+type PhoneCallLogType string
+
+func (p PhoneCallLogType) String() string {
+	return string(p)
+}
+
+type PhoneVoicemailStatus string
+
+func (p PhoneVoicemailStatus) String() string {
+	return string(p)
+}
+
+type PhoneServicer interface {
+	ListUsers(ctx context.Context, opts *PhoneListUsersOptions) (*PhoneListUsersResponse, *http.Response, error)
+	GetUser(ctx context.Context, userID string) (*PhoneUser, *http.Response, error)
+
+	ListCallLogs(ctx context.Context, opts *PhoneListCallLogsOptions) (*PhoneListCallLogsResponse, *http.Response, error)
+	ListCallLogsRange(ctx context.Context, from, to time.Time, opts *PhoneListCallLogsOptions) ([]*PhoneCallLog, error)
+	ListUserCallLogs(ctx context.Context, userID string, opts *PhoneListCallLogsOptions) (*PhoneListCallLogsResponse, *http.Response, error)
+	ListUserCallLogsRange(ctx context.Context, userID string, from, to time.Time, opts *PhoneListCallLogsOptions) ([]*PhoneCallLog, error)
+	GetCallLog(ctx context.Context, callLogID string) (*PhoneCallLog, *http.Response, error)
+
+	ListRecordings(ctx context.Context, opts *PhoneListRecordingsOptions) (*PhoneListRecordingsResponse, *http.Response, error)
+	ListRecordingsRange(ctx context.Context, from, to time.Time, opts *PhoneListRecordingsOptions) ([]*PhoneRecording, error)
+	ListUserRecordings(ctx context.Context, userID string, opts *PhoneListRecordingsOptions) (*PhoneListRecordingsResponse, *http.Response, error)
+	DownloadRecording(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error)
+
+	ListVoicemails(ctx context.Context, opts *PhoneListVoicemailsOptions) (*PhoneListVoicemailsResponse, *http.Response, error)
+	ListUserVoicemails(ctx context.Context, userID string, opts *PhoneListVoicemailsOptions) (*PhoneListVoicemailsResponse, *http.Response, error)
+	GetVoicemail(ctx context.Context, voicemailID string) (*PhoneVoicemail, *http.Response, error)
+	DownloadVoicemail(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error)
+
+	ListCallQueues(ctx context.Context, opts *PhoneListSiteOptions) (*PhoneListCallQueuesResponse, *http.Response, error)
+	GetCallQueue(ctx context.Context, callQueueID string) (*PhoneCallQueue, *http.Response, error)
+	ListAutoReceptionists(ctx context.Context, opts *PhoneListSiteOptions) (*PhoneListAutoReceptionistsResponse, *http.Response, error)
+	GetAutoReceptionist(ctx context.Context, autoReceptionistID string) (*PhoneAutoReceptionist, *http.Response, error)
+}
+
+// PhoneService covers Zoom Phone. The account must have Zoom Phone enabled.
+type PhoneService struct {
+	client *Client
+}
+
+var _ PhoneServicer = (*PhoneService)(nil)
+
+type PhoneSite struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PhoneNumber struct {
+	ID     string `json:"id"`
+	Number string `json:"number"`
+}
+
+type PhoneCallingPlan struct {
+	Name string `json:"name"`
+	Type int    `json:"type"`
+}
+
+type PhoneListUsersOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	CallingType *int    `url:"calling_type,omitempty"`
+	Department  *string `url:"department,omitempty"`
+	Keyword     *string `url:"keyword,omitempty"`
+	SiteID      *string `url:"site_id,omitempty"`
+	Status      *string `url:"status,omitempty"`
+}
+
+type PhoneListUsersResponse struct {
+	*PaginationResponse
+
+	Users []*PhoneUser `json:"users"`
+}
+
+type PhoneUser struct {
+	CallingPlans    []*PhoneCallingPlan `json:"calling_plans"`
+	CostCenter      string              `json:"cost_center"`
+	Department      string              `json:"department"`
+	Email           string              `json:"email"`
+	ExtensionID     string              `json:"extension_id"`
+	ExtensionNumber int64               `json:"extension_number"`
+	ID              string              `json:"id"`
+	Name            string              `json:"name"`
+	PhoneNumbers    []*PhoneNumber      `json:"phone_numbers"`
+	Site            *PhoneSite          `json:"site"`
+	SiteID          string              `json:"site_id"`
+	Status          string              `json:"status"`
+}
+
+func (p *PhoneService) ListUsers(ctx context.Context, opts *PhoneListUsersOptions) (*PhoneListUsersResponse, *http.Response, error) {
+	out := &PhoneListUsersResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/users", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// GetUser returns a user's phone profile. userID may be a user ID or email address.
+func (p *PhoneService) GetUser(ctx context.Context, userID string) (*PhoneUser, *http.Response, error) {
+	out := &PhoneUser{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/users/"+url.QueryEscape(userID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// PhoneListCallLogsOptions filters call logs. SiteID only applies to the account's call logs.
+type PhoneListCallLogsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From   *time.Time        `url:"from,omitempty" layout:"2006-01-02"`
+	SiteID *string           `url:"site_id,omitempty"`
+	To     *time.Time        `url:"to,omitempty" layout:"2006-01-02"`
+	Type   *PhoneCallLogType `url:"type,omitempty"`
+}
+
+type PhoneListCallLogsResponse struct {
+	*PaginationResponse
+
+	CallLogs []*PhoneCallLog `json:"call_logs"`
+	From     string          `json:"from"`
+	To       string          `json:"to"`
+}
+
+type PhoneCallLog struct {
+	CallID        string     `json:"call_id"`
+	CalleeName    string     `json:"callee_name"`
+	CalleeNumber  string     `json:"callee_number"`
+	CallerName    string     `json:"caller_name"`
+	CallerNumber  string     `json:"caller_number"`
+	Charge        string     `json:"charge"`
+	DateTime      time.Time  `json:"date_time"`
+	Direction     string     `json:"direction"`
+	Duration      int        `json:"duration"`
+	HasRecording  bool       `json:"has_recording"`
+	HasVoicemail  bool       `json:"has_voicemail"`
+	ID            string     `json:"id"`
+	Path          string     `json:"path"`
+	RecordingID   string     `json:"recording_id"`
+	RecordingType string     `json:"recording_type"`
+	Result        string     `json:"result"`
+	Site          *PhoneSite `json:"site"`
+	UserID        string     `json:"user_id"`
+	WaitingTime   int        `json:"waiting_time"`
+}
+
+// ListCallLogs lists the account's call logs, one page at a time.
+func (p *PhoneService) ListCallLogs(ctx context.Context, opts *PhoneListCallLogsOptions) (*PhoneListCallLogsResponse, *http.Response, error) {
+	out := &PhoneListCallLogsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/call_logs", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListCallLogsRange lists every call log of the account between from and to (inclusive). The range is split into Zoom's
+// maximum window and each window is paginated through, so any From, To and NextPageToken set on opts are ignored.
+func (p *PhoneService) ListCallLogsRange(ctx context.Context, from, to time.Time, opts *PhoneListCallLogsOptions) ([]*PhoneCallLog, error) {
+	return p.callLogsRange(ctx, from, to, opts, p.ListCallLogs)
+}
+
+// ListUserCallLogs lists a user's call logs, one page at a time.
+func (p *PhoneService) ListUserCallLogs(ctx context.Context, userID string, opts *PhoneListCallLogsOptions) (*PhoneListCallLogsResponse, *http.Response, error) {
+	out := &PhoneListCallLogsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/users/"+url.QueryEscape(userID)+"/call_logs", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListUserCallLogsRange lists every call log of a user between from and to (inclusive) the same way as
+// ListCallLogsRange.
+func (p *PhoneService) ListUserCallLogsRange(ctx context.Context, userID string, from, to time.Time, opts *PhoneListCallLogsOptions) ([]*PhoneCallLog, error) {
+	return p.callLogsRange(ctx, from, to, opts, func(ctx context.Context, pageOpts *PhoneListCallLogsOptions) (*PhoneListCallLogsResponse, *http.Response, error) {
+		return p.ListUserCallLogs(ctx, userID, pageOpts)
+	})
+}
+
+func (p *PhoneService) callLogsRange(ctx context.Context, from, to time.Time, opts *PhoneListCallLogsOptions, list func(context.Context, *PhoneListCallLogsOptions) (*PhoneListCallLogsResponse, *http.Response, error)) ([]*PhoneCallLog, error) {
+	rangeOpts := &PhoneListCallLogsOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	callLogs, err := listAllInRange(ctx, from, to, phoneMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*PhoneCallLog, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := list(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.CallLogs, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing call logs: %w", err)
+	}
+
+	return callLogs, nil
+}
+
+func (p *PhoneService) GetCallLog(ctx context.Context, callLogID string) (*PhoneCallLog, *http.Response, error) {
+	out := &PhoneCallLog{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/call_logs/"+url.QueryEscape(callLogID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// PhoneListRecordingsOptions filters call recordings. OwnerType, RecordingType and SiteID only apply to the account's
+// recordings.
+type PhoneListRecordingsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From          *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	OwnerType     *string    `url:"owner_type,omitempty"`
+	RecordingType *string    `url:"recording_type,omitempty"`
+	SiteID        *string    `url:"site_id,omitempty"`
+	To            *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+type PhoneListRecordingsResponse struct {
+	*PaginationResponse
+
+	From       string            `json:"from"`
+	Recordings []*PhoneRecording `json:"recordings"`
+	To         string            `json:"to"`
+}
+
+type PhoneRecording struct {
+	CallID        string               `json:"call_id"`
+	CallLogID     string               `json:"call_log_id"`
+	CalleeName    string               `json:"callee_name"`
+	CalleeNumber  string               `json:"callee_number"`
+	CallerName    string               `json:"caller_name"`
+	CallerNumber  string               `json:"caller_number"`
+	DateTime      time.Time            `json:"date_time"`
+	Direction     string               `json:"direction"`
+	DownloadURL   string               `json:"download_url"`
+	Duration      int                  `json:"duration"`
+	EndTime       time.Time            `json:"end_time"`
+	ID            string               `json:"id"`
+	Owner         *PhoneRecordingOwner `json:"owner"`
+	RecordingType string               `json:"recording_type"`
+	Site          *PhoneSite           `json:"site"`
+}
+
+type PhoneRecordingOwner struct {
+	ExtensionNumber int64  `json:"extension_number"`
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+}
+
+// ListRecordings lists the account's call recordings, one page at a time.
+func (p *PhoneService) ListRecordings(ctx context.Context, opts *PhoneListRecordingsOptions) (*PhoneListRecordingsResponse, *http.Response, error) {
+	out := &PhoneListRecordingsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/recordings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListRecordingsRange lists every call recording of the account between from and to (inclusive). The range is split
+// into Zoom's maximum window and each window is paginated through, so any From, To and NextPageToken set on opts are
+// ignored.
+func (p *PhoneService) ListRecordingsRange(ctx context.Context, from, to time.Time, opts *PhoneListRecordingsOptions) ([]*PhoneRecording, error) {
+	rangeOpts := &PhoneListRecordingsOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	recordings, err := listAllInRange(ctx, from, to, phoneMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*PhoneRecording, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := p.ListRecordings(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Recordings, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing recordings: %w", err)
+	}
+
+	return recordings, nil
+}
+
+func (p *PhoneService) ListUserRecordings(ctx context.Context, userID string, opts *PhoneListRecordingsOptions) (*PhoneListRecordingsResponse, *http.Response, error) {
+	out := &PhoneListRecordingsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/users/"+url.QueryEscape(userID)+"/recordings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// DownloadRecording streams a call recording's DownloadURL into w. See DownloadOptions for resuming and verifying
+// downloads.
+func (p *PhoneService) DownloadRecording(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error) {
+	return p.client.download(ctx, downloadURL, w, opts)
+}
+
+type PhoneListVoicemailsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From   *time.Time            `url:"from,omitempty" layout:"2006-01-02"`
+	Status *PhoneVoicemailStatus `url:"status,omitempty"`
+	To     *time.Time            `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+type PhoneListVoicemailsResponse struct {
+	*PaginationResponse
+
+	From       string            `json:"from"`
+	To         string            `json:"to"`
+	Voicemails []*PhoneVoicemail `json:"voice_mails"`
+}
+
+type PhoneVoicemail struct {
+	CallID       string               `json:"call_id"`
+	CallLogID    string               `json:"call_log_id"`
+	CalleeName   string               `json:"callee_name"`
+	CalleeNumber string               `json:"callee_number"`
+	CallerName   string               `json:"caller_name"`
+	CallerNumber string               `json:"caller_number"`
+	DateTime     time.Time            `json:"date_time"`
+	DownloadURL  string               `json:"download_url"`
+	Duration     int                  `json:"duration"`
+	ID           string               `json:"id"`
+	Status       PhoneVoicemailStatus `json:"status"`
+}
+
+// ListVoicemails lists the voicemails of the account, one page at a time.
+func (p *PhoneService) ListVoicemails(ctx context.Context, opts *PhoneListVoicemailsOptions) (*PhoneListVoicemailsResponse, *http.Response, error) {
+	out := &PhoneListVoicemailsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/voice_mails", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (p *PhoneService) ListUserVoicemails(ctx context.Context, userID string, opts *PhoneListVoicemailsOptions) (*PhoneListVoicemailsResponse, *http.Response, error) {
+	out := &PhoneListVoicemailsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/users/"+url.QueryEscape(userID)+"/voice_mails", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (p *PhoneService) GetVoicemail(ctx context.Context, voicemailID string) (*PhoneVoicemail, *http.Response, error) {
+	out := &PhoneVoicemail{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/voice_mails/"+url.QueryEscape(voicemailID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// DownloadVoicemail streams a voicemail's DownloadURL into w. See DownloadOptions for resuming and verifying downloads.
+func (p *PhoneService) DownloadVoicemail(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error) {
+	return p.client.download(ctx, downloadURL, w, opts)
+}
+
+type PhoneListSiteOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	SiteID *string `url:"site_id,omitempty"`
+}
+
+type PhoneListCallQueuesResponse struct {
+	*PaginationResponse
+
+	CallQueues []*PhoneCallQueue `json:"call_queues"`
+}
+
+type PhoneCallQueue struct {
+	CostCenter      string                 `json:"cost_center"`
+	Department      string                 `json:"department"`
+	ExtensionID     string                 `json:"extension_id"`
+	ExtensionNumber int64                  `json:"extension_number"`
+	ID              string                 `json:"id"`
+	Members         *PhoneCallQueueMembers `json:"members"`
+	Name            string                 `json:"name"`
+	PhoneNumbers    []*PhoneNumber         `json:"phone_numbers"`
+	Site            *PhoneSite             `json:"site"`
+	Status          string                 `json:"status"`
+	Timezone        string                 `json:"timezone"`
+}
+
+// PhoneCallQueueMembers are only returned by GetCallQueue.
+type PhoneCallQueueMembers struct {
+	Users []*PhoneCallQueueMember `json:"users"`
+}
+
+type PhoneCallQueueMember struct {
+	ID          string `json:"id"`
+	Level       string `json:"level"`
+	Name        string `json:"name"`
+	ReceiveCall bool   `json:"receive_call"`
+}
+
+func (p *PhoneService) ListCallQueues(ctx context.Context, opts *PhoneListSiteOptions) (*PhoneListCallQueuesResponse, *http.Response, error) {
+	out := &PhoneListCallQueuesResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/call_queues", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (p *PhoneService) GetCallQueue(ctx context.Context, callQueueID string) (*PhoneCallQueue, *http.Response, error) {
+	out := &PhoneCallQueue{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/call_queues/"+url.QueryEscape(callQueueID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type PhoneListAutoReceptionistsResponse struct {
+	*PaginationResponse
+
+	AutoReceptionists []*PhoneAutoReceptionist `json:"auto_receptionists"`
+}
+
+type PhoneAutoReceptionist struct {
+	CostCenter      string         `json:"cost_center"`
+	Department      string         `json:"department"`
+	ExtensionID     string         `json:"extension_id"`
+	ExtensionNumber int64          `json:"extension_number"`
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	PhoneNumbers    []*PhoneNumber `json:"phone_numbers"`
+	Site            *PhoneSite     `json:"site"`
+	Timezone        string         `json:"timezone"`
+}
+
+func (p *PhoneService) ListAutoReceptionists(ctx context.Context, opts *PhoneListSiteOptions) (*PhoneListAutoReceptionistsResponse, *http.Response, error) {
+	out := &PhoneListAutoReceptionistsResponse{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/auto_receptionists", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (p *PhoneService) GetAutoReceptionist(ctx context.Context, autoReceptionistID string) (*PhoneAutoReceptionist, *http.Response, error) {
+	out := &PhoneAutoReceptionist{}
+
+	res, err := p.client.request(ctx, http.MethodGet, "/phone/auto_receptionists/"+url.QueryEscape(autoReceptionistID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
@@ -0,0 +1,60 @@
+package zoom
+
+const (
+	PollTypePoll     PollType = 1
+	PollTypeAdvanced PollType = 2
+	PollTypeQuiz     PollType = 3
+)
+
+// PollType indicates whether a poll is a regular poll, an advanced poll or a quiz. Polls are shared by meetings and webinars.
+type PollType int
+
+func (p PollType) Int() int {
+	return int(p)
+}
+
+type PollsListResponse struct {
+	Polls        []*Poll `json:"polls"`
+	TotalRecords int     `json:"total_records"`
+}
+
+type Poll struct {
+	Anonymous bool            `json:"anonymous"`
+	ID        string          `json:"id"`
+	PollType  PollType        `json:"poll_type"`
+	Questions []*PollQuestion `json:"questions"`
+	Status    string          `json:"status"`
+	Title     string          `json:"title"`
+}
+
+// PollQuestion is used both to read and to create or update a poll's questions.
+type PollQuestion struct {
+	AnswerMaxCharacter int                   `json:"answer_max_character,omitempty"`
+	AnswerMinCharacter int                   `json:"answer_min_character,omitempty"`
+	AnswerRequired     bool                  `json:"answer_required,omitempty"`
+	Answers            []string              `json:"answers,omitempty"`
+	CaseSensitive      bool                  `json:"case_sensitive,omitempty"`
+	Name               string                `json:"name"`
+	Prompts            []*PollQuestionPrompt `json:"prompts,omitempty"`
+	RatingMaxLabel     string                `json:"rating_max_label,omitempty"`
+	RatingMaxValue     int                   `json:"rating_max_value,omitempty"`
+	RatingMinLabel     string                `json:"rating_min_label,omitempty"`
+	RatingMinValue     *int                  `json:"rating_min_value,omitempty"`
+	RightAnswers       []string              `json:"right_answers,omitempty"`
+	ShowAsDropdown     bool                  `json:"show_as_dropdown,omitempty"`
+	Type               string                `json:"type"`
+}
+
+type PollQuestionPrompt struct {
+	PromptQuestion     string   `json:"prompt_question"`
+	PromptRightAnswers []string `json:"prompt_right_answers,omitempty"`
+}
+
+type PollsCreateOptions struct {
+	Anonymous *bool           `json:"anonymous,omitempty"`
+	PollType  *PollType       `json:"poll_type,omitempty"`
+	Questions []*PollQuestion `json:"questions"`
+	Title     string          `json:"title"`
+}
+
+type PollsUpdateOptions PollsCreateOptions
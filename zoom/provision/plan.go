@@ -0,0 +1,206 @@
+package provision
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const (
+	ActionNone       Action = "none"
+	ActionCreate     Action = "create"
+	ActionUpdate     Action = "update"
+	ActionDeactivate Action = "deactivate"
+	ActionDelete     Action = "delete"
+
+	FieldFirstName = "first_name"
+	FieldLastName  = "last_name"
+	FieldType      = "type"
+	FieldDept      = "dept"
+	FieldStatus    = "status"
+	FieldGroupIDs  = "group_ids"
+	FieldRoleID    = "role_id"
+
+	statusInactive = "inactive"
+)
+
+type Action string
+
+func (a Action) String() string {
+	return string(a)
+}
+
+type PlanOptions struct {
+	// Remove is the action taken on Zoom users missing from the desired users: ActionDeactivate, ActionDelete or, when
+	// empty, nothing.
+	Remove Action
+	// Protected lists the emails of users that are never deactivated or deleted, such as the account owner.
+	Protected []string
+}
+
+// Change is a single planned change to a Zoom user.
+type Change struct {
+	Action Action
+	Email  string
+	// Row is the desired user's row, or zero for users that are only in Zoom.
+	Row int
+	// Fields lists the fields an update changes.
+	Fields []string
+
+	Desired *User
+	Current *zoom.UsersListItem
+}
+
+type Plan struct {
+	Changes []*Change
+}
+
+// Count returns the number of changes with the given action.
+func (p *Plan) Count(action Action) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// NewPlan compares the desired users with the current Zoom users, matching them by email. The plan holds a change
+// for every desired user, in order, followed by changes for the Zoom users missing from the desired users.
+func NewPlan(desired []*User, current []*zoom.UsersListItem, opts *PlanOptions) (*Plan, error) {
+	if opts == nil {
+		opts = &PlanOptions{}
+	}
+
+	switch opts.Remove {
+	case "", ActionNone, ActionDeactivate, ActionDelete:
+	default:
+		return nil, errors.New("remove must be deactivate or delete")
+	}
+
+	byEmail := map[string]*zoom.UsersListItem{}
+	for _, user := range current {
+		byEmail[strings.ToLower(user.Email)] = user
+	}
+
+	plan := &Plan{}
+	wanted := map[string]bool{}
+
+	for _, user := range desired {
+		key := strings.ToLower(user.Email)
+		wanted[key] = true
+
+		change := &Change{
+			Action:  ActionNone,
+			Email:   user.Email,
+			Row:     user.Row,
+			Desired: user,
+			Current: byEmail[key],
+		}
+
+		if change.Current == nil {
+			change.Action = ActionCreate
+		} else {
+			change.Fields = diff(user, change.Current)
+			if len(change.Fields) > 0 {
+				change.Action = ActionUpdate
+			}
+		}
+
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if opts.Remove != "" && opts.Remove != ActionNone {
+		protected := map[string]bool{}
+		for _, email := range opts.Protected {
+			protected[strings.ToLower(email)] = true
+		}
+
+		var removals []*Change
+		for key, user := range byEmail {
+			if wanted[key] || protected[key] {
+				continue
+			}
+
+			if opts.Remove == ActionDeactivate && user.Status == statusInactive {
+				continue
+			}
+
+			removals = append(removals, &Change{
+				Action:  opts.Remove,
+				Email:   user.Email,
+				Current: user,
+			})
+		}
+
+		sort.Slice(removals, func(i, j int) bool {
+			return removals[i].Email < removals[j].Email
+		})
+
+		plan.Changes = append(plan.Changes, removals...)
+	}
+
+	return plan, nil
+}
+
+// diff returns the managed fields of desired that differ from current.
+func diff(desired *User, current *zoom.UsersListItem) []string {
+	var fields []string
+
+	if len(desired.FirstName) > 0 && desired.FirstName != current.FirstName {
+		fields = append(fields, FieldFirstName)
+	}
+
+	if len(desired.LastName) > 0 && desired.LastName != current.LastName {
+		fields = append(fields, FieldLastName)
+	}
+
+	if desired.Type != 0 && desired.Type != current.Type {
+		fields = append(fields, FieldType)
+	}
+
+	if len(desired.Dept) > 0 && desired.Dept != current.Dept {
+		fields = append(fields, FieldDept)
+	}
+
+	if current.Status == statusInactive {
+		fields = append(fields, FieldStatus)
+	}
+
+	if desired.GroupIDs != nil {
+		add, remove := groupChanges(desired.GroupIDs, current.GroupIDs)
+		if len(add) > 0 || len(remove) > 0 {
+			fields = append(fields, FieldGroupIDs)
+		}
+	}
+
+	if len(desired.RoleID) > 0 && desired.RoleID != current.RoleID {
+		fields = append(fields, FieldRoleID)
+	}
+
+	return fields
+}
+
+// groupChanges returns the groups a user must be added to and removed from to go from current to desired.
+func groupChanges(desired []string, current []string) ([]string, []string) {
+	var add, remove []string
+
+	for _, groupID := range desired {
+		if !slices.Contains(current, groupID) {
+			add = append(add, groupID)
+		}
+	}
+
+	for _, groupID := range current {
+		if !slices.Contains(desired, groupID) {
+			remove = append(remove, groupID)
+		}
+	}
+
+	return add, remove
+}
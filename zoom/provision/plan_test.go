@@ -0,0 +1,82 @@
+package provision
+
+import (
+	"testing"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPlan(t *testing.T) {
+	assert := assert.New(t)
+
+	desired := []*User{
+		{Row: 1, Email: "new@example.com", FirstName: "New"},
+		{Row: 2, Email: "Same@example.com", FirstName: "Same", Dept: "Sales", GroupIDs: []string{"g1"}},
+		{Row: 3, Email: "changed@example.com", LastName: "Changed", Type: 2, GroupIDs: []string{"g2"}, RoleID: "r2"},
+		{Row: 4, Email: "inactive@example.com"},
+	}
+
+	current := []*zoom.UsersListItem{
+		{ID: "u1", Email: "same@example.com", FirstName: "Same", LastName: "Unmanaged", Dept: "Sales", GroupIDs: []string{"g1"}, Type: 1, Status: "active"},
+		{ID: "u2", Email: "changed@example.com", LastName: "Old", Type: 1, GroupIDs: []string{"g1"}, RoleID: "r1", Status: "active"},
+		{ID: "u3", Email: "inactive@example.com", Status: "inactive"},
+		{ID: "u4", Email: "owner@example.com", Status: "active"},
+		{ID: "u5", Email: "b-extra@example.com", Status: "active"},
+		{ID: "u6", Email: "a-extra@example.com", Status: "pending"},
+		{ID: "u7", Email: "gone@example.com", Status: "inactive"},
+	}
+
+	plan, err := NewPlan(desired, current, &PlanOptions{
+		Remove:    ActionDeactivate,
+		Protected: []string{"OWNER@example.com"},
+	})
+	assert.NoError(err)
+
+	var summary [][]any
+	for _, change := range plan.Changes {
+		summary = append(summary, []any{change.Row, change.Email, change.Action, change.Fields})
+	}
+
+	assert.Equal([][]any{
+		{1, "new@example.com", ActionCreate, []string(nil)},
+		{2, "Same@example.com", ActionNone, []string(nil)},
+		{3, "changed@example.com", ActionUpdate, []string{FieldLastName, FieldType, FieldGroupIDs, FieldRoleID}},
+		{4, "inactive@example.com", ActionUpdate, []string{FieldStatus}},
+		{0, "a-extra@example.com", ActionDeactivate, []string(nil)},
+		{0, "b-extra@example.com", ActionDeactivate, []string(nil)},
+	}, summary)
+
+	assert.Equal(1, plan.Count(ActionCreate))
+	assert.Equal(2, plan.Count(ActionUpdate))
+	assert.Equal(2, plan.Count(ActionDeactivate))
+	assert.Equal(current[1], plan.Changes[2].Current)
+}
+
+func TestNewPlan_Remove(t *testing.T) {
+	assert := assert.New(t)
+
+	current := []*zoom.UsersListItem{
+		{ID: "u1", Email: "gone@example.com", Status: "inactive"},
+	}
+
+	plan, err := NewPlan(nil, current, nil)
+	assert.NoError(err)
+	assert.Empty(plan.Changes)
+
+	plan, err = NewPlan(nil, current, &PlanOptions{Remove: ActionDelete})
+	assert.NoError(err)
+	assert.Equal(1, plan.Count(ActionDelete))
+
+	_, err = NewPlan(nil, current, &PlanOptions{Remove: ActionUpdate})
+	assert.Error(err)
+}
+
+func TestGroupChanges(t *testing.T) {
+	assert := assert.New(t)
+
+	add, remove := groupChanges([]string{"g1", "g2"}, []string{"g2", "g3"})
+
+	assert.Equal([]string{"g1"}, add)
+	assert.Equal([]string{"g3"}, remove)
+}
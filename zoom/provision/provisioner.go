@@ -0,0 +1,384 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+// listStatuses are the user statuses listed when reading the current users, as Zoom lists only active users by default.
+var listStatuses = []string{"active", "inactive", "pending"}
+
+type Provisioner struct {
+	users  zoom.UsersServicer
+	groups zoom.GroupsServicer
+	roles  zoom.RolesServicer
+
+	// Concurrency is the number of changes applied at the same time. Values below 1 apply one change at a time.
+	Concurrency int
+	// Interval is the minimum time between two requests to Zoom, across all concurrent changes. Zero disables rate
+	// limiting.
+	Interval time.Duration
+	// CreateAction is the action used to create users, zoom.ActionCreate by default.
+	CreateAction zoom.UsersCreateAction
+}
+
+func New(users zoom.UsersServicer, groups zoom.GroupsServicer, roles zoom.RolesServicer) *Provisioner {
+	return &Provisioner{
+		users:  users,
+		groups: groups,
+		roles:  roles,
+
+		Concurrency:  1,
+		CreateAction: zoom.ActionCreate,
+	}
+}
+
+// CurrentUsers lists every active, inactive and pending user of the account.
+func (p *Provisioner) CurrentUsers(ctx context.Context) ([]*zoom.UsersListItem, error) {
+	var users []*zoom.UsersListItem
+
+	for _, status := range listStatuses {
+		opts := &zoom.UsersListOptions{
+			PaginationOptions: &zoom.PaginationOptions{
+				PageSize: zoom.Ptr(300),
+			},
+			Status: zoom.Ptr(status),
+		}
+
+		for {
+			err := ctx.Err()
+			if err != nil {
+				return nil, err
+			}
+
+			out, _, err := p.users.List(ctx, opts)
+			if err != nil {
+				return nil, fmt.Errorf("listing %s users: %w", status, err)
+			}
+
+			users = append(users, out.Users...)
+
+			if out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+				break
+			}
+
+			opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+		}
+	}
+
+	return users, nil
+}
+
+// Plan lists the current users and compares them with the desired users.
+func (p *Provisioner) Plan(ctx context.Context, desired []*User, opts *PlanOptions) (*Plan, error) {
+	current, err := p.CurrentUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlan(desired, current, opts)
+}
+
+// Result is the outcome of applying a single change.
+type Result struct {
+	*Change
+
+	DryRun bool
+	Err    error
+}
+
+// Apply applies every change of the plan and returns one result per change, in the plan's order. A failed change
+// does not stop the others. When dryRun is true nothing is sent to Zoom.
+func (p *Provisioner) Apply(ctx context.Context, plan *Plan, dryRun bool) []*Result {
+	results := make([]*Result, len(plan.Changes))
+	for i, change := range plan.Changes {
+		results[i] = &Result{
+			Change: change,
+			DryRun: dryRun,
+		}
+	}
+
+	if dryRun {
+		return results
+	}
+
+	limiter := newLimiter(p.Interval)
+	defer limiter.stop()
+
+	sem := make(chan struct{}, max(p.Concurrency, 1))
+	var wg sync.WaitGroup
+
+	for _, result := range results {
+		if result.Action == ActionNone {
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result.Err = p.apply(ctx, limiter, result.Change)
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+func (p *Provisioner) apply(ctx context.Context, limiter *limiter, change *Change) error {
+	switch change.Action {
+	case ActionCreate:
+		return p.create(ctx, limiter, change.Desired)
+	case ActionUpdate:
+		return p.update(ctx, limiter, change)
+	case ActionDeactivate:
+		err := limiter.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.users.UpdateStatus(ctx, change.Current.ID, &zoom.UsersUpdateStatusOptions{
+			Action: zoom.UsersStatusActionDeactivate,
+		})
+		if err != nil {
+			return fmt.Errorf("deactivating user: %w", err)
+		}
+
+		return nil
+	case ActionDelete:
+		err := limiter.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.users.Delete(ctx, change.Current.ID, nil)
+		if err != nil {
+			return fmt.Errorf("deleting user: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown action %q", change.Action)
+	}
+}
+
+func (p *Provisioner) create(ctx context.Context, limiter *limiter, user *User) error {
+	err := limiter.wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	userInfo := &zoom.UsersCreateOptionsUserInfo{
+		Email: user.Email,
+		Type:  max(user.Type, 1),
+	}
+
+	if len(user.FirstName) > 0 {
+		userInfo.FirstName = zoom.Ptr(user.FirstName)
+	}
+
+	if len(user.LastName) > 0 {
+		userInfo.LastName = zoom.Ptr(user.LastName)
+	}
+
+	out, _, err := p.users.Create(ctx, &zoom.UsersCreateOptions{
+		Action:   p.CreateAction,
+		UserInfo: userInfo,
+	})
+	if err != nil {
+		return fmt.Errorf("creating user: %w", err)
+	}
+
+	if len(user.Dept) > 0 {
+		err = limiter.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.users.Update(ctx, out.ID, &zoom.UsersUpdateOptions{
+			Dept: zoom.Ptr(user.Dept),
+		})
+		if err != nil {
+			return fmt.Errorf("setting department: %w", err)
+		}
+	}
+
+	for _, groupID := range user.GroupIDs {
+		err = p.addToGroup(ctx, limiter, groupID, out.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(user.RoleID) > 0 {
+		err = p.assignRole(ctx, limiter, user.RoleID, out.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Provisioner) update(ctx context.Context, limiter *limiter, change *Change) error {
+	user := change.Desired
+	userID := change.Current.ID
+
+	if slices.Contains(change.Fields, FieldStatus) {
+		err := limiter.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.users.UpdateStatus(ctx, userID, &zoom.UsersUpdateStatusOptions{
+			Action: zoom.UsersStatusActionActivate,
+		})
+		if err != nil {
+			return fmt.Errorf("activating user: %w", err)
+		}
+	}
+
+	opts := &zoom.UsersUpdateOptions{}
+	changed := false
+
+	for _, field := range change.Fields {
+		switch field {
+		case FieldFirstName:
+			opts.FirstName = zoom.Ptr(user.FirstName)
+		case FieldLastName:
+			opts.LastName = zoom.Ptr(user.LastName)
+		case FieldType:
+			opts.Type = zoom.Ptr(user.Type)
+		case FieldDept:
+			opts.Dept = zoom.Ptr(user.Dept)
+		default:
+			continue
+		}
+
+		changed = true
+	}
+
+	if changed {
+		err := limiter.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.users.Update(ctx, userID, opts)
+		if err != nil {
+			return fmt.Errorf("updating user: %w", err)
+		}
+	}
+
+	if slices.Contains(change.Fields, FieldGroupIDs) {
+		add, remove := groupChanges(user.GroupIDs, change.Current.GroupIDs)
+
+		for _, groupID := range add {
+			err := p.addToGroup(ctx, limiter, groupID, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, groupID := range remove {
+			err := limiter.wait(ctx)
+			if err != nil {
+				return err
+			}
+
+			_, err = p.groups.RemoveMember(ctx, groupID, userID)
+			if err != nil {
+				return fmt.Errorf("removing from group %s: %w", groupID, err)
+			}
+		}
+	}
+
+	if slices.Contains(change.Fields, FieldRoleID) {
+		err := p.assignRole(ctx, limiter, user.RoleID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Provisioner) addToGroup(ctx context.Context, limiter *limiter, groupID string, userID string) error {
+	err := limiter.wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = p.groups.AddMembers(ctx, groupID, &zoom.GroupsAddMembersOptions{
+		Members: []*zoom.UserIdentifier{{ID: zoom.Ptr(userID)}},
+	})
+	if err != nil {
+		return fmt.Errorf("adding to group %s: %w", groupID, err)
+	}
+
+	return nil
+}
+
+func (p *Provisioner) assignRole(ctx context.Context, limiter *limiter, roleID string, userID string) error {
+	err := limiter.wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = p.roles.AssignMembers(ctx, roleID, &zoom.RolesAssignMembersOptions{
+		Members: []*zoom.UserIdentifier{{ID: zoom.Ptr(userID)}},
+	})
+	if err != nil {
+		return fmt.Errorf("assigning role %s: %w", roleID, err)
+	}
+
+	return nil
+}
+
+// limiter spaces requests at least an interval apart. A limiter with no interval never waits.
+type limiter struct {
+	ticker *time.Ticker
+}
+
+func newLimiter(interval time.Duration) *limiter {
+	if interval <= 0 {
+		return &limiter{}
+	}
+
+	return &limiter{
+		ticker: time.NewTicker(interval),
+	}
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+	if l.ticker == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case <-l.ticker.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *limiter) stop() {
+	if l.ticker != nil {
+		l.ticker.Stop()
+	}
+}
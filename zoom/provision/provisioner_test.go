@@ -0,0 +1,259 @@
+package provision
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeZoom records the calls made through the fake users, groups and roles servicers.
+type fakeZoom struct {
+	users map[string][]*zoom.UsersListItem
+	fail  map[string]bool
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (f *fakeZoom) call(format string, args ...any) error {
+	call := fmt.Sprintf(format, args...)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, call)
+	if f.fail[call] {
+		return errors.New("zoom error")
+	}
+
+	return nil
+}
+
+func (f *fakeZoom) sortedCalls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := append([]string(nil), f.calls...)
+	sort.Strings(calls)
+
+	return calls
+}
+
+type fakeUsers struct {
+	zoom.UsersServicer
+	*fakeZoom
+}
+
+type fakeGroups struct {
+	zoom.GroupsServicer
+	*fakeZoom
+}
+
+type fakeRoles struct {
+	zoom.RolesServicer
+	*fakeZoom
+}
+
+func (f *fakeZoom) provisioner() *Provisioner {
+	return New(&fakeUsers{fakeZoom: f}, &fakeGroups{fakeZoom: f}, &fakeRoles{fakeZoom: f})
+}
+
+func (f *fakeUsers) List(ctx context.Context, opts *zoom.UsersListOptions) (*zoom.UsersListResponse, *http.Response, error) {
+	users := f.users[*opts.Status]
+
+	// Serve one user per page to exercise pagination.
+	start := 0
+	if opts.NextPageToken != nil {
+		fmt.Sscan(*opts.NextPageToken, &start)
+	}
+
+	out := &zoom.UsersListResponse{PaginationResponse: &zoom.PaginationResponse{}}
+	if start < len(users) {
+		out.Users = users[start : start+1]
+	}
+	if start+1 < len(users) {
+		out.NextPageToken = fmt.Sprint(start + 1)
+	}
+
+	return out, nil, nil
+}
+
+func (f *fakeUsers) Create(ctx context.Context, opts *zoom.UsersCreateOptions) (*zoom.UsersCreateResponse, *http.Response, error) {
+	err := f.call("create %s type=%d action=%s", opts.UserInfo.Email, opts.UserInfo.Type, opts.Action)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &zoom.UsersCreateResponse{ID: "id-" + opts.UserInfo.Email}, nil, nil
+}
+
+func (f *fakeUsers) Update(ctx context.Context, userID string, opts *zoom.UsersUpdateOptions) (*http.Response, error) {
+	return nil, f.call("update %s dept=%v type=%v", userID, opts.Dept != nil, opts.Type != nil)
+}
+
+func (f *fakeUsers) UpdateStatus(ctx context.Context, userID string, opts *zoom.UsersUpdateStatusOptions) (*http.Response, error) {
+	return nil, f.call("status %s %s", userID, opts.Action)
+}
+
+func (f *fakeUsers) Delete(ctx context.Context, userID string, opts *zoom.UsersDeleteOptions) (*http.Response, error) {
+	return nil, f.call("delete %s", userID)
+}
+
+func (f *fakeGroups) AddMembers(ctx context.Context, groupID string, opts *zoom.GroupsAddMembersOptions) (*zoom.GroupsAddMembersResponse, *http.Response, error) {
+	return &zoom.GroupsAddMembersResponse{}, nil, f.call("group %s add %s", groupID, *opts.Members[0].ID)
+}
+
+func (f *fakeGroups) RemoveMember(ctx context.Context, groupID string, memberID string) (*http.Response, error) {
+	return nil, f.call("group %s remove %s", groupID, memberID)
+}
+
+func (f *fakeRoles) AssignMembers(ctx context.Context, roleID string, opts *zoom.RolesAssignMembersOptions) (*zoom.RolesAssignMembersResponse, *http.Response, error) {
+	return &zoom.RolesAssignMembersResponse{}, nil, f.call("role %s assign %s", roleID, *opts.Members[0].ID)
+}
+
+func newFakeZoom() *fakeZoom {
+	return &fakeZoom{
+		users: map[string][]*zoom.UsersListItem{
+			"active": {
+				{ID: "u1", Email: "same@example.com", Status: "active"},
+				{ID: "u2", Email: "changed@example.com", Type: 1, GroupIDs: []string{"g1"}, Status: "active"},
+				{ID: "u3", Email: "extra@example.com", Status: "active"},
+			},
+			"inactive": {
+				{ID: "u4", Email: "returning@example.com", Status: "inactive"},
+			},
+		},
+		fail: map[string]bool{},
+	}
+}
+
+var desiredUsers = []*User{
+	{Row: 1, Email: "new@example.com", Dept: "Sales", GroupIDs: []string{"g1"}, RoleID: "r1"},
+	{Row: 2, Email: "same@example.com"},
+	{Row: 3, Email: "changed@example.com", Type: 2, GroupIDs: []string{"g2"}},
+	{Row: 4, Email: "returning@example.com"},
+}
+
+func TestProvisioner_CurrentUsers(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeZoom()
+	users, err := fake.provisioner().CurrentUsers(context.Background())
+
+	assert.NoError(err)
+	assert.Len(users, 4)
+}
+
+func TestProvisioner_Apply(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeZoom()
+	p := fake.provisioner()
+	p.Concurrency = 3
+	p.Interval = time.Millisecond
+
+	plan, err := p.Plan(context.Background(), desiredUsers, &PlanOptions{Remove: ActionDeactivate})
+	assert.NoError(err)
+
+	results := p.Apply(context.Background(), plan, false)
+
+	assert.Len(results, 5)
+	for _, result := range results {
+		assert.NoError(result.Err)
+	}
+
+	assert.Equal([]string{
+		"create new@example.com type=1 action=create",
+		"group g1 add id-new@example.com",
+		"group g1 remove u2",
+		"group g2 add u2",
+		"role r1 assign id-new@example.com",
+		"status u3 deactivate",
+		"status u4 activate",
+		"update id-new@example.com dept=true type=false",
+		"update u2 dept=false type=true",
+	}, fake.sortedCalls())
+}
+
+func TestProvisioner_Apply_DryRun(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeZoom()
+	p := fake.provisioner()
+
+	plan, err := p.Plan(context.Background(), desiredUsers, nil)
+	assert.NoError(err)
+
+	results := p.Apply(context.Background(), plan, true)
+
+	assert.Len(results, 4)
+	assert.Empty(fake.sortedCalls())
+	assert.Equal(ResultStatusDryRun, results[0].Status())
+	assert.Equal(ResultStatusSkipped, results[1].Status())
+}
+
+func TestProvisioner_Apply_Failure(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeZoom()
+	fake.fail["create new@example.com type=1 action=create"] = true
+	p := fake.provisioner()
+
+	plan, err := p.Plan(context.Background(), desiredUsers, nil)
+	assert.NoError(err)
+
+	results := p.Apply(context.Background(), plan, false)
+
+	assert.ErrorContains(results[0].Err, "creating user")
+	assert.Equal(ResultStatusFailed, results[0].Status())
+	assert.NoError(results[2].Err)
+	assert.Equal(ResultStatusOK, results[2].Status())
+	assert.NotContains(fake.sortedCalls(), "group g1 add id-new@example.com")
+}
+
+func TestProvisioner_Apply_Canceled(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeZoom()
+	p := fake.provisioner()
+
+	plan, err := p.Plan(context.Background(), desiredUsers, nil)
+	assert.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := p.Apply(ctx, plan, false)
+
+	assert.ErrorIs(results[0].Err, context.Canceled)
+	assert.Empty(fake.sortedCalls())
+}
+
+func TestWriteReport(t *testing.T) {
+	assert := assert.New(t)
+
+	results := []*Result{
+		{Change: &Change{Row: 1, Email: "new@example.com", Action: ActionCreate}},
+		{Change: &Change{Row: 2, Email: "changed@example.com", Action: ActionUpdate, Fields: []string{FieldType, FieldDept}}, Err: errors.New("updating user: bad type")},
+		{Change: &Change{Email: "extra@example.com", Action: ActionDeactivate}, DryRun: true},
+	}
+
+	buf := &bytes.Buffer{}
+	err := WriteReport(buf, results)
+
+	assert.NoError(err)
+	assert.Equal(`row,email,action,fields,status,error
+1,new@example.com,create,,ok,
+2,changed@example.com,update,type dept,failed,updating user: bad type
+,extra@example.com,deactivate,,dry-run,
+`, buf.String())
+}
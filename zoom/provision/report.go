@@ -0,0 +1,69 @@
+package provision
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	ResultStatusOK      = "ok"
+	ResultStatusFailed  = "failed"
+	ResultStatusDryRun  = "dry-run"
+	ResultStatusSkipped = "skipped"
+)
+
+// Status returns ResultStatusSkipped for changes that needed nothing, ResultStatusDryRun for changes that were only
+// planned, and otherwise ResultStatusOK or ResultStatusFailed.
+func (r *Result) Status() string {
+	switch {
+	case r.Action == ActionNone:
+		return ResultStatusSkipped
+	case r.Err != nil:
+		return ResultStatusFailed
+	case r.DryRun:
+		return ResultStatusDryRun
+	default:
+		return ResultStatusOK
+	}
+}
+
+// WriteReport writes one CSV row per result with the row, email, action, changed fields, status and error.
+func WriteReport(w io.Writer, results []*Result) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{"row", "email", "action", "fields", "status", "error"})
+	if err != nil {
+		return fmt.Errorf("writing header: %w", err)
+	}
+
+	for _, result := range results {
+		row := ""
+		if result.Row > 0 {
+			row = strconv.Itoa(result.Row)
+		}
+
+		errMessage := ""
+		if result.Err != nil {
+			errMessage = result.Err.Error()
+		}
+
+		err = writer.Write([]string{
+			row,
+			result.Email,
+			result.Action.String(),
+			strings.Join(result.Fields, " "),
+			result.Status(),
+			errMessage,
+		})
+		if err != nil {
+			return fmt.Errorf("writing result for %s: %w", result.Email, err)
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
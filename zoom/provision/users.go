@@ -0,0 +1,166 @@
+package provision
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const groupIDsSeparator = ";"
+
+var ErrDuplicateEmail = errors.New("duplicate email")
+var ErrMissingEmail = errors.New("missing email")
+
+// User is the desired state of a Zoom user. Empty fields are not managed: they are left as they are in Zoom.
+type User struct {
+	// Row is the position of the user in its source, starting at 1, so results can be traced back to the input.
+	Row int `json:"-"`
+
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Type      int    `json:"type"`
+	Dept      string `json:"dept"`
+	// GroupIDs are the groups the user must belong to. Nil leaves the user's groups as they are, while an empty
+	// slice removes the user from every group.
+	GroupIDs []string `json:"group_ids"`
+	RoleID   string   `json:"role_id"`
+}
+
+// ReadCSV reads users from CSV with a header row. The email column is required and the first_name, last_name, type,
+// dept, group_ids and role_id columns are optional. Group IDs are separated by semicolons.
+func ReadCSV(r io.Reader) ([]*User, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch name {
+		case "email", "first_name", "last_name", "type", "dept", "group_ids", "role_id":
+		default:
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+
+		columns[name] = i
+	}
+
+	_, ok := columns["email"]
+	if !ok {
+		return nil, errors.New("missing email column")
+	}
+
+	var users []*User
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading row %d: %w", row, err)
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		user := &User{
+			Row:       row,
+			Email:     field("email"),
+			FirstName: field("first_name"),
+			LastName:  field("last_name"),
+			Dept:      field("dept"),
+			RoleID:    field("role_id"),
+		}
+
+		if t := field("type"); len(t) > 0 {
+			user.Type, err = strconv.Atoi(t)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: parsing type: %w", row, err)
+			}
+		}
+
+		if _, ok := columns["group_ids"]; ok {
+			user.GroupIDs = []string{}
+
+			for _, groupID := range strings.Split(field("group_ids"), groupIDsSeparator) {
+				groupID = strings.TrimSpace(groupID)
+				if len(groupID) > 0 {
+					user.GroupIDs = append(user.GroupIDs, groupID)
+				}
+			}
+		}
+
+		users = append(users, user)
+	}
+
+	err = validate(users)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ReadJSON reads users from a JSON array of objects using the same field names as the CSV columns.
+func ReadJSON(r io.Reader) ([]*User, error) {
+	var users []*User
+
+	err := json.NewDecoder(r).Decode(&users)
+	if err != nil {
+		return nil, fmt.Errorf("decoding users: %w", err)
+	}
+
+	for i, user := range users {
+		if user == nil {
+			return nil, fmt.Errorf("row %d: null user", i+1)
+		}
+
+		user.Row = i + 1
+	}
+
+	err = validate(users)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// validate normalizes emails and checks that every user has a unique email.
+func validate(users []*User) error {
+	seen := map[string]int{}
+
+	for _, user := range users {
+		user.Email = strings.TrimSpace(user.Email)
+		if len(user.Email) == 0 {
+			return fmt.Errorf("row %d: %w", user.Row, ErrMissingEmail)
+		}
+
+		key := strings.ToLower(user.Email)
+
+		row, ok := seen[key]
+		if ok {
+			return fmt.Errorf("row %d: %w %s, first seen on row %d", user.Row, ErrDuplicateEmail, user.Email, row)
+		}
+
+		seen[key] = user.Row
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package provision
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	users, err := ReadCSV(strings.NewReader(`email,first_name,last_name,type,dept,group_ids,role_id
+jane@example.com,Jane,Doe,2,Sales,g1;g2,r1
+john@example.com,John,,,,,
+`))
+
+	assert.NoError(err)
+	assert.Equal([]*User{
+		{Row: 1, Email: "jane@example.com", FirstName: "Jane", LastName: "Doe", Type: 2, Dept: "Sales", GroupIDs: []string{"g1", "g2"}, RoleID: "r1"},
+		{Row: 2, Email: "john@example.com", FirstName: "John", GroupIDs: []string{}},
+	}, users)
+}
+
+func TestReadCSV_OptionalColumns(t *testing.T) {
+	assert := assert.New(t)
+
+	users, err := ReadCSV(strings.NewReader("Email\njane@example.com\n"))
+
+	assert.NoError(err)
+	assert.Len(users, 1)
+	assert.Nil(users[0].GroupIDs)
+}
+
+func TestReadCSV_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := ReadCSV(strings.NewReader("first_name\nJane\n"))
+	assert.ErrorContains(err, "missing email column")
+
+	_, err = ReadCSV(strings.NewReader("email,phone\njane@example.com,123\n"))
+	assert.ErrorContains(err, `unknown column "phone"`)
+
+	_, err = ReadCSV(strings.NewReader("email,type\njane@example.com,pro\n"))
+	assert.ErrorContains(err, "row 1: parsing type")
+
+	_, err = ReadCSV(strings.NewReader("email\njane@example.com\n\"\"\n"))
+	assert.ErrorIs(err, ErrMissingEmail)
+
+	_, err = ReadCSV(strings.NewReader("email\njane@example.com\nJANE@example.com\n"))
+	assert.ErrorIs(err, ErrDuplicateEmail)
+}
+
+func TestReadJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	users, err := ReadJSON(strings.NewReader(`[
+		{"email": "jane@example.com", "first_name": "Jane", "group_ids": []},
+		{"email": " john@example.com ", "role_id": "r1"}
+	]`))
+
+	assert.NoError(err)
+	assert.Equal([]*User{
+		{Row: 1, Email: "jane@example.com", FirstName: "Jane", GroupIDs: []string{}},
+		{Row: 2, Email: "john@example.com", RoleID: "r1"},
+	}, users)
+
+	_, err = ReadJSON(strings.NewReader(`[{"email": "jane@example.com"}, {"email": "jane@example.com"}]`))
+	assert.ErrorIs(err, ErrDuplicateEmail)
+}
@@ -0,0 +1,173 @@
+package reconcile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultHost = "me"
+
+// startTimeLayouts are the accepted layouts of a meeting's start_time, a wall clock time in the meeting's timezone.
+var startTimeLayouts = []string{"2006-01-02T15:04", "2006-01-02T15:04:05", "2006-01-02 15:04", "2006-01-02 15:04:05"}
+
+// Config is the desired state of a host's managed meetings.
+type Config struct {
+	// Host is the ID or email of the user hosting the meetings, "me" by default.
+	Host     string     `yaml:"host"`
+	Meetings []*Meeting `yaml:"meetings"`
+}
+
+type Meeting struct {
+	// Key identifies the meeting across runs. It is stored in the meeting's tracking field or agenda.
+	Key    string `yaml:"key"`
+	Topic  string `yaml:"topic"`
+	Agenda string `yaml:"agenda"`
+	// StartTime is the wall clock time of the first occurrence in Timezone, such as 2024-01-08T09:30.
+	StartTime  string      `yaml:"start_time"`
+	Timezone   string      `yaml:"timezone"`
+	Duration   int         `yaml:"duration"`
+	Recurrence *Recurrence `yaml:"recurrence"`
+	Settings   *Settings   `yaml:"settings"`
+
+	start    time.Time
+	location *time.Location
+}
+
+// Recurrence uses the values of Zoom's recurrence object. Type is 1 for daily, 2 for weekly and 3 for monthly
+// meetings, and weekly days are comma separated numbers from 1 for Sunday to 7 for Saturday.
+type Recurrence struct {
+	Type           int    `yaml:"type"`
+	RepeatInterval int    `yaml:"repeat_interval"`
+	WeeklyDays     string `yaml:"weekly_days"`
+	MonthlyDay     int    `yaml:"monthly_day"`
+	MonthlyWeek    int    `yaml:"monthly_week"`
+	MonthlyWeekDay int    `yaml:"monthly_week_day"`
+	EndTimes       int    `yaml:"end_times"`
+	// EndDate is the date of the last occurrence, such as 2024-06-30.
+	EndDate string `yaml:"end_date"`
+
+	endDate time.Time
+}
+
+// Settings holds the meeting settings that can be reconciled. Unset settings are not managed.
+type Settings struct {
+	AlternativeHosts *string `yaml:"alternative_hosts"`
+	AutoRecording    *string `yaml:"auto_recording"`
+	HostVideo        *bool   `yaml:"host_video"`
+	JoinBeforeHost   *bool   `yaml:"join_before_host"`
+	MuteUponEntry    *bool   `yaml:"mute_upon_entry"`
+	ParticipantVideo *bool   `yaml:"participant_video"`
+	WaitingRoom      *bool   `yaml:"waiting_room"`
+}
+
+// Load reads and validates a YAML config. Unknown fields are rejected so that typos do not silently go unmanaged.
+func Load(r io.Reader) (*Config, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	cfg := &Config{}
+	err := decoder.Decode(cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decoding config: %w", err)
+	}
+
+	err = cfg.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if len(c.Host) == 0 {
+		c.Host = defaultHost
+	}
+
+	keys := map[string]bool{}
+
+	for i, m := range c.Meetings {
+		if m == nil || len(m.Key) == 0 {
+			return fmt.Errorf("meeting %d: missing key", i+1)
+		}
+
+		if keys[m.Key] {
+			return fmt.Errorf("meeting %s: duplicate key", m.Key)
+		}
+		keys[m.Key] = true
+
+		err := m.validate()
+		if err != nil {
+			return fmt.Errorf("meeting %s: %w", m.Key, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Meeting) validate() error {
+	if len(m.Topic) == 0 {
+		return errors.New("missing topic")
+	}
+
+	if m.Duration <= 0 {
+		return errors.New("duration must be positive")
+	}
+
+	var err error
+	m.location = time.UTC
+	if len(m.Timezone) > 0 {
+		m.location, err = zoom.LoadTimezone(m.Timezone)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, layout := range startTimeLayouts {
+		m.start, err = time.ParseInLocation(layout, m.StartTime, m.location)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("parsing start_time %q", m.StartTime)
+	}
+
+	if m.Recurrence != nil {
+		raw := &zoom.MeetingsCreateResponseRecurrance{
+			Type:           m.Recurrence.Type,
+			RepeatInterval: m.Recurrence.RepeatInterval,
+			WeeklyDays:     m.Recurrence.WeeklyDays,
+			MonthlyDay:     m.Recurrence.MonthlyDay,
+			MonthlyWeek:    m.Recurrence.MonthlyWeek,
+			MonthlyWeekDay: m.Recurrence.MonthlyWeekDay,
+			EndTimes:       m.Recurrence.EndTimes,
+		}
+
+		_, err = raw.Recurrence()
+		if err != nil {
+			return err
+		}
+
+		if len(m.Recurrence.EndDate) > 0 {
+			if m.Recurrence.EndTimes > 0 {
+				return errors.New("recurrence cannot have both end_date and end_times")
+			}
+
+			endDate, err := time.ParseInLocation("2006-01-02", m.Recurrence.EndDate, m.location)
+			if err != nil {
+				return fmt.Errorf("parsing recurrence end_date: %w", err)
+			}
+
+			// Zoom expects the end as a date time, so end on the last day at the meeting's start time.
+			m.Recurrence.endDate = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), m.start.Hour(), m.start.Minute(), 0, 0, m.location)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package reconcile
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg, err := Load(strings.NewReader(`
+meetings:
+  - key: standup
+    topic: Team standup
+    start_time: 2024-01-08T09:30
+    timezone: America/New_York
+    duration: 15
+    recurrence:
+      type: 2
+      weekly_days: "2,3,4,5,6"
+      end_date: 2024-06-28
+    settings:
+      waiting_room: false
+`))
+
+	assert.NoError(err)
+	assert.Equal("me", cfg.Host)
+	assert.Len(cfg.Meetings, 1)
+
+	m := cfg.Meetings[0]
+	assert.Equal(time.Date(2024, 1, 8, 14, 30, 0, 0, time.UTC), m.start.UTC())
+	assert.Equal(time.Date(2024, 6, 28, 13, 30, 0, 0, time.UTC), m.Recurrence.endDate.UTC())
+	assert.False(*m.Settings.WaitingRoom)
+	assert.Nil(m.Settings.HostVideo)
+}
+
+func TestLoad_ZoomTimezone(t *testing.T) {
+	assert := assert.New(t)
+
+	// Zoom lists SST as the Solomon Islands, which is not an IANA timezone.
+	cfg, err := Load(strings.NewReader("meetings:\n  - {key: a, topic: a, duration: 1, start_time: 2024-01-08T09:30, timezone: SST}\n"))
+
+	assert.NoError(err)
+	assert.Equal(time.Date(2024, 1, 7, 22, 30, 0, 0, time.UTC), cfg.Meetings[0].start.UTC())
+}
+
+func TestLoad_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	for yaml, message := range map[string]string{
+		"meetings:\n  - topic: a\n":                                                                                                                 "missing key",
+		"meetings:\n  - key: a\n    duration: 30\n":                                                                                                 "missing topic",
+		"meetings:\n  - key: a\n    topic: a\n":                                                                                                     "duration must be positive",
+		"meetings:\n  - key: a\n    topic: a\n    duration: 30\n":                                                                                   "parsing start_time",
+		"meetings:\n  - key: a\n    topc: a\n":                                                                                                      "field topc not found",
+		"meetings:\n  - {key: a, topic: a, duration: 1, start_time: 2024-01-08T09:30}\n  - {key: a}\n":                                              "duplicate key",
+		"meetings:\n  - {key: a, topic: a, duration: 1, start_time: 2024-01-08T09:30, timezone: Mars/Olympus}\n":                                    "not supported by Zoom",
+		"meetings:\n  - {key: a, topic: a, duration: 1, start_time: 2024-01-08T09:30, recurrence: {type: 4}}\n":                                     "unknown type 4",
+		"meetings:\n  - {key: a, topic: a, duration: 1, start_time: 2024-01-08T09:30, recurrence: {type: 1, end_times: 3, end_date: 2024-02-01}}\n": "both end_date and end_times",
+	} {
+		_, err := Load(strings.NewReader(yaml))
+		assert.ErrorContains(err, message, yaml)
+	}
+}
@@ -0,0 +1,184 @@
+package reconcile
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const (
+	ActionNone   Action = "none"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+type Action string
+
+func (a Action) String() string {
+	return string(a)
+}
+
+type Plan struct {
+	Host    string
+	Changes []*Change
+}
+
+// HasChanges reports whether applying the plan would change anything, such as to fail a CI check on drift.
+func (p *Plan) HasChanges() bool {
+	for _, change := range p.Changes {
+		if change.Action != ActionNone {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Change struct {
+	Action Action
+	Key    string
+	// MeetingID is zero for meetings that are yet to be created.
+	MeetingID int64
+	// Drift lists the fields of an existing meeting that differ from the desired meeting.
+	Drift []*Drift
+
+	desired *Meeting
+}
+
+type Drift struct {
+	Field   string
+	Desired string
+	Current string
+}
+
+// WriteReport writes one line per change describing what applying the plan does, followed by its drift.
+func WriteReport(w io.Writer, plan *Plan) error {
+	for _, change := range plan.Changes {
+		line := change.Action.String() + " " + change.Key
+		if change.MeetingID != 0 {
+			line += " (" + strconv.FormatInt(change.MeetingID, 10) + ")"
+		}
+
+		_, err := fmt.Fprintln(w, line)
+		if err != nil {
+			return err
+		}
+
+		for _, drift := range change.Drift {
+			_, err = fmt.Fprintf(w, "  %s: %q => %q\n", drift.Field, drift.Current, drift.Desired)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// diff compares a desired meeting with its current state. The start time of recurring meetings is compared by its
+// time of day only, as Zoom reports the start of the next occurrence.
+func diff(m *Meeting, current *zoom.MeetingsGetResponse, agenda string) []*Drift {
+	var drift []*Drift
+
+	compare := func(field string, desired any, current any) {
+		d, c := fmt.Sprint(desired), fmt.Sprint(current)
+		if d != c {
+			drift = append(drift, &Drift{Field: field, Desired: d, Current: c})
+		}
+	}
+
+	compare("topic", m.Topic, current.Topic)
+	compare("agenda", agenda, current.Agenda)
+	compare("duration", m.Duration, current.Duration)
+	compare("type", meetingType(m).Int(), current.Type)
+
+	if len(m.Timezone) > 0 {
+		compare("timezone", m.Timezone, current.Timezone)
+	}
+
+	start := current.StartTime.In(m.location)
+	if m.Recurrence == nil {
+		compare("start_time", m.start.Format(startTimeLayouts[0]), start.Format(startTimeLayouts[0]))
+	} else {
+		compare("start_time", m.start.Format("15:04"), start.Format("15:04"))
+	}
+
+	if rec := m.Recurrence; rec != nil {
+		cur := current.Recurrence
+		if cur == nil {
+			cur = &zoom.MeetingsCreateResponseRecurrance{}
+		}
+
+		compare("recurrence.type", rec.Type, cur.Type)
+		compare("recurrence.repeat_interval", max(rec.RepeatInterval, 1), max(cur.RepeatInterval, 1))
+		compare("recurrence.weekly_days", weeklyDays(rec.WeeklyDays), weeklyDays(cur.WeeklyDays))
+		compare("recurrence.monthly_day", rec.MonthlyDay, cur.MonthlyDay)
+		compare("recurrence.monthly_week", rec.MonthlyWeek, cur.MonthlyWeek)
+		compare("recurrence.monthly_week_day", rec.MonthlyWeekDay, cur.MonthlyWeekDay)
+		compare("recurrence.end_times", rec.EndTimes, cur.EndTimes)
+
+		endDate := ""
+		if !cur.EndDateTime.IsZero() && rec.EndTimes == 0 {
+			endDate = cur.EndDateTime.In(m.location).Format("2006-01-02")
+		}
+		compare("recurrence.end_date", rec.EndDate, endDate)
+	}
+
+	if s := m.Settings; s != nil {
+		cur := current.Settings
+		if cur == nil {
+			cur = &zoom.MeetingCreateResponseSettings{}
+		}
+
+		if s.AlternativeHosts != nil {
+			compare("settings.alternative_hosts", *s.AlternativeHosts, cur.AlternativeHosts)
+		}
+
+		if s.AutoRecording != nil {
+			compare("settings.auto_recording", *s.AutoRecording, cur.AutoRecording)
+		}
+
+		if s.HostVideo != nil {
+			compare("settings.host_video", *s.HostVideo, cur.HostVideo)
+		}
+
+		if s.JoinBeforeHost != nil {
+			compare("settings.join_before_host", *s.JoinBeforeHost, cur.JoinBeforeHost)
+		}
+
+		if s.MuteUponEntry != nil {
+			compare("settings.mute_upon_entry", *s.MuteUponEntry, cur.MuteUponEntry)
+		}
+
+		if s.ParticipantVideo != nil {
+			compare("settings.participant_video", *s.ParticipantVideo, cur.ParticipantVideo)
+		}
+
+		if s.WaitingRoom != nil {
+			compare("settings.waiting_room", *s.WaitingRoom, cur.WaitingRoom)
+		}
+	}
+
+	return drift
+}
+
+// weeklyDays sorts a comma separated list of days and removes its spaces and duplicates, so the same days listed in
+// another order don't drift.
+func weeklyDays(days string) string {
+	var list []string
+	for _, day := range strings.Split(days, ",") {
+		day = strings.TrimSpace(day)
+		if len(day) > 0 {
+			list = append(list, day)
+		}
+	}
+
+	slices.Sort(list)
+
+	return strings.Join(slices.Compact(list), ",")
+}
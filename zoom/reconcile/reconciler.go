@@ -0,0 +1,322 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+// AgendaMarker prefixes the line appended to a meeting's agenda to hold its key when no tracking field is used.
+const AgendaMarker = "reconcile-key: "
+
+type Reconciler struct {
+	meetings zoom.MeetingsServicer
+
+	// TrackingField is the name of the tracking field holding each meeting's key. When empty, keys are held in a last
+	// agenda line starting with AgendaMarker.
+	TrackingField string
+	// Prune deletes managed meetings whose keys are no longer desired. Meetings without a key are never touched.
+	Prune bool
+}
+
+func New(meetings zoom.MeetingsServicer) *Reconciler {
+	return &Reconciler{
+		meetings: meetings,
+	}
+}
+
+// key returns the key of a meeting, or an empty string for meetings that are not managed.
+func (r *Reconciler) key(meeting *zoom.MeetingsGetResponse) string {
+	if len(r.TrackingField) > 0 {
+		for _, field := range meeting.TrackingFields {
+			if field.Field == r.TrackingField {
+				return field.Value
+			}
+		}
+
+		return ""
+	}
+
+	_, key := splitAgenda(meeting.Agenda)
+
+	return key
+}
+
+// splitAgenda separates an agenda from the key in its marker line.
+func splitAgenda(agenda string) (string, string) {
+	i := strings.LastIndex(agenda, AgendaMarker)
+	if i < 0 || (i > 0 && agenda[i-1] != '\n') || strings.Contains(agenda[i:], "\n") {
+		return agenda, ""
+	}
+
+	return strings.TrimRight(agenda[:i], "\n"), strings.TrimSpace(agenda[i+len(AgendaMarker):])
+}
+
+// Current lists the host's scheduled meetings, including their details, which the list endpoint leaves out.
+func (r *Reconciler) Current(ctx context.Context, host string) ([]*zoom.MeetingsGetResponse, error) {
+	var meetings []*zoom.MeetingsGetResponse
+	seen := map[int64]bool{}
+
+	opts := &zoom.MeetingsListOptions{
+		PaginationOptions: &zoom.PaginationOptions{
+			PageSize: zoom.Ptr(300),
+		},
+		Type: zoom.Ptr("scheduled"),
+	}
+
+	for {
+		out, _, err := r.meetings.List(ctx, host, opts)
+		if err != nil {
+			return nil, fmt.Errorf("listing meetings: %w", err)
+		}
+
+		for _, item := range out.Meetings {
+			if seen[item.ID] {
+				continue
+			}
+			seen[item.ID] = true
+
+			meeting, _, err := r.meetings.Get(ctx, item.ID, nil)
+			if err != nil {
+				return nil, fmt.Errorf("getting meeting %d: %w", item.ID, err)
+			}
+
+			meetings = append(meetings, meeting)
+		}
+
+		if out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+			return meetings, nil
+		}
+
+		opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+	}
+}
+
+// Plan compares the config's meetings with the host's current meetings without changing anything.
+func (r *Reconciler) Plan(ctx context.Context, cfg *Config) (*Plan, error) {
+	current, err := r.Current(ctx, cfg.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.plan(cfg, current), nil
+}
+
+func (r *Reconciler) plan(cfg *Config, current []*zoom.MeetingsGetResponse) *Plan {
+	plan := &Plan{
+		Host: cfg.Host,
+	}
+
+	byKey := map[string][]*zoom.MeetingsGetResponse{}
+	var keys []string
+	for _, meeting := range current {
+		key := r.key(meeting)
+		if len(key) == 0 {
+			continue
+		}
+
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], meeting)
+	}
+
+	desired := map[string]bool{}
+
+	for _, m := range cfg.Meetings {
+		desired[m.Key] = true
+
+		change := &Change{
+			Action:  ActionNone,
+			Key:     m.Key,
+			desired: m,
+		}
+
+		matches := byKey[m.Key]
+		if len(matches) == 0 {
+			change.Action = ActionCreate
+		} else {
+			change.MeetingID = matches[0].ID
+			change.Drift = diff(m, matches[0], r.agenda(m))
+			if len(change.Drift) > 0 {
+				change.Action = ActionUpdate
+			}
+		}
+
+		plan.Changes = append(plan.Changes, change)
+
+		// Only the first meeting with a key is reconciled, the others are duplicates left by an interrupted run.
+		if len(matches) > 1 && r.Prune {
+			for _, duplicate := range matches[1:] {
+				plan.Changes = append(plan.Changes, &Change{
+					Action:    ActionDelete,
+					Key:       m.Key,
+					MeetingID: duplicate.ID,
+				})
+			}
+		}
+	}
+
+	if r.Prune {
+		for _, key := range keys {
+			if desired[key] {
+				continue
+			}
+
+			for _, meeting := range byKey[key] {
+				plan.Changes = append(plan.Changes, &Change{
+					Action:    ActionDelete,
+					Key:       key,
+					MeetingID: meeting.ID,
+				})
+			}
+		}
+	}
+
+	return plan
+}
+
+// agenda returns the agenda to set on a meeting, including its key marker when keys are not held in a tracking field.
+func (r *Reconciler) agenda(m *Meeting) string {
+	if len(r.TrackingField) > 0 {
+		return m.Agenda
+	}
+
+	if len(m.Agenda) == 0 {
+		return AgendaMarker + m.Key
+	}
+
+	return m.Agenda + "\n\n" + AgendaMarker + m.Key
+}
+
+func (r *Reconciler) createOptions(m *Meeting) *zoom.MeetingsCreateOptions {
+	opts := &zoom.MeetingsCreateOptions{
+		Agenda:    zoom.Ptr(r.agenda(m)),
+		Duration:  zoom.Ptr(m.Duration),
+		StartTime: &zoom.StartTime{Time: m.start, Timezone: m.Timezone},
+		Topic:     zoom.Ptr(m.Topic),
+		Type:      zoom.Ptr(meetingType(m).Int()),
+	}
+
+	if len(m.Timezone) > 0 {
+		opts.Timezone = zoom.Ptr(m.Timezone)
+	}
+
+	if len(r.TrackingField) > 0 {
+		opts.TrackingFields = []*zoom.MeetingsCreateResponseTrackingField{
+			{Field: r.TrackingField, Value: m.Key},
+		}
+	}
+
+	if rec := m.Recurrence; rec != nil {
+		opts.Recurrence = &zoom.MeetingsCreateOptionsRecurrence{
+			Type: rec.Type,
+		}
+
+		if rec.RepeatInterval > 0 {
+			opts.Recurrence.RepeatInterval = zoom.Ptr(rec.RepeatInterval)
+		}
+
+		if len(rec.WeeklyDays) > 0 {
+			opts.Recurrence.WeeklyDays = zoom.Ptr(weeklyDays(rec.WeeklyDays))
+		}
+
+		if rec.MonthlyDay > 0 {
+			opts.Recurrence.MonthlyDay = zoom.Ptr(rec.MonthlyDay)
+		}
+
+		if rec.MonthlyWeek != 0 {
+			opts.Recurrence.MonthlyWeek = zoom.Ptr(rec.MonthlyWeek)
+		}
+
+		if rec.MonthlyWeekDay > 0 {
+			opts.Recurrence.MonthlyWeekDay = zoom.Ptr(rec.MonthlyWeekDay)
+		}
+
+		if rec.EndTimes > 0 {
+			opts.Recurrence.EndTimes = zoom.Ptr(rec.EndTimes)
+		}
+
+		if !rec.endDate.IsZero() {
+			opts.Recurrence.EndDateTime = zoom.Ptr(rec.endDate.UTC())
+		}
+	}
+
+	if s := m.Settings; s != nil {
+		opts.Settings = &zoom.MeetingsCreateOptionsSettings{
+			AlternativeHosts: s.AlternativeHosts,
+			AutoRecording:    s.AutoRecording,
+			HostVideo:        s.HostVideo,
+			JoinBeforeHost:   s.JoinBeforeHost,
+			MuteUponEntry:    s.MuteUponEntry,
+			ParticipantVideo: s.ParticipantVideo,
+			WaitingRoom:      s.WaitingRoom,
+		}
+	}
+
+	return opts
+}
+
+func meetingType(m *Meeting) zoom.MeetingType {
+	if m.Recurrence != nil {
+		return zoom.MeetingTypeRecurringFixed
+	}
+
+	return zoom.MeetingTypeScheduled
+}
+
+// Result is the outcome of applying a single change.
+type Result struct {
+	*Change
+
+	Err error
+}
+
+// Apply applies every change of the plan in order and returns one result per change. A failed change does not stop
+// the others. Created meetings have their MeetingID set.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) []*Result {
+	results := make([]*Result, len(plan.Changes))
+
+	for i, change := range plan.Changes {
+		results[i] = &Result{Change: change}
+
+		err := ctx.Err()
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		switch change.Action {
+		case ActionCreate:
+			var out *zoom.MeetingsCreateResponse
+			out, _, err = r.meetings.Create(ctx, plan.Host, r.createOptions(change.desired))
+			if err == nil {
+				change.MeetingID = out.ID
+			} else {
+				err = fmt.Errorf("creating meeting: %w", err)
+			}
+		case ActionUpdate:
+			opts := r.createOptions(change.desired)
+			// The key is already in place, and replacing tracking fields would drop those set outside the reconciler.
+			opts.TrackingFields = nil
+
+			_, err = r.meetings.Update(ctx, change.MeetingID, &zoom.MeetingsUpdateOptions{
+				MeetingsCreateOptions: opts,
+			})
+			if err != nil {
+				err = fmt.Errorf("updating meeting: %w", err)
+			}
+		case ActionDelete:
+			_, err = r.meetings.Delete(ctx, change.MeetingID, nil)
+			if err != nil {
+				err = fmt.Errorf("deleting meeting: %w", err)
+			}
+		}
+
+		results[i].Err = err
+	}
+
+	return results
+}
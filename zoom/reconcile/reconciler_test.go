@@ -0,0 +1,252 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMeetings keeps meetings in memory.
+type fakeMeetings struct {
+	zoom.MeetingsServicer
+
+	meetings []*zoom.MeetingsGetResponse
+	nextID   int64
+	calls    []string
+
+	created *zoom.MeetingsCreateOptions
+	updated *zoom.MeetingsUpdateOptions
+}
+
+func (f *fakeMeetings) List(ctx context.Context, userID string, opts *zoom.MeetingsListOptions) (*zoom.MeetingsListResponse, *http.Response, error) {
+	out := &zoom.MeetingsListResponse{}
+	for _, meeting := range f.meetings {
+		out.Meetings = append(out.Meetings, &zoom.MeetingsListItem{ID: meeting.ID})
+	}
+
+	return out, nil, nil
+}
+
+func (f *fakeMeetings) Get(ctx context.Context, meetingID int64, opts *zoom.MeetingsGetOptions) (*zoom.MeetingsGetResponse, *http.Response, error) {
+	for _, meeting := range f.meetings {
+		if meeting.ID == meetingID {
+			return meeting, nil, nil
+		}
+	}
+
+	return nil, nil, errors.New("not found")
+}
+
+func (f *fakeMeetings) Create(ctx context.Context, userID string, opts *zoom.MeetingsCreateOptions) (*zoom.MeetingsCreateResponse, *http.Response, error) {
+	f.calls = append(f.calls, "create "+userID+" "+*opts.Topic)
+	f.created = opts
+	f.nextID++
+
+	return &zoom.MeetingsCreateResponse{ID: 1000 + f.nextID}, nil, nil
+}
+
+func (f *fakeMeetings) Update(ctx context.Context, meetingID int64, opts *zoom.MeetingsUpdateOptions) (*http.Response, error) {
+	f.calls = append(f.calls, "update "+*opts.Topic)
+	f.updated = opts
+
+	return nil, nil
+}
+
+func (f *fakeMeetings) Delete(ctx context.Context, meetingID int64, opts *zoom.MeetingsDeleteOptions) (*http.Response, error) {
+	f.calls = append(f.calls, "delete")
+
+	return nil, errors.New("meeting already started")
+}
+
+const testConfig = `
+host: host@example.com
+meetings:
+  - key: standup
+    topic: Team standup
+    agenda: Daily sync
+    start_time: 2024-01-08T09:30
+    timezone: America/New_York
+    duration: 15
+    recurrence:
+      type: 2
+      weekly_days: "2,3,4,5,6"
+  - key: retro
+    topic: Retro
+    start_time: 2024-01-12T15:00
+    timezone: America/New_York
+    duration: 60
+    settings:
+      waiting_room: true
+`
+
+func loadTestConfig(t *testing.T) *Config {
+	cfg, err := Load(strings.NewReader(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cfg
+}
+
+func newFakeMeetings() *fakeMeetings {
+	return &fakeMeetings{
+		meetings: []*zoom.MeetingsGetResponse{
+			{MeetingsCreateResponse: zoom.MeetingsCreateResponse{
+				ID:        1,
+				Topic:     "Team standup",
+				Agenda:    "Daily sync\n\nreconcile-key: standup",
+				StartTime: zoom.StartTime{Time: time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)},
+				Timezone:  "America/New_York",
+				Duration:  30,
+				Type:      8,
+				Recurrence: &zoom.MeetingsCreateResponseRecurrance{
+					Type:           2,
+					RepeatInterval: 1,
+					WeeklyDays:     "2,3,4,5,6",
+				},
+			}},
+			{MeetingsCreateResponse: zoom.MeetingsCreateResponse{
+				ID:     2,
+				Topic:  "Someone else's meeting",
+				Agenda: "Not managed",
+			}},
+			{MeetingsCreateResponse: zoom.MeetingsCreateResponse{
+				ID:     3,
+				Topic:  "Old planning",
+				Agenda: "reconcile-key: planning",
+			}},
+		},
+	}
+}
+
+func TestSplitAgenda(t *testing.T) {
+	assert := assert.New(t)
+
+	agenda, key := splitAgenda("Daily sync\n\nreconcile-key: standup")
+	assert.Equal("Daily sync", agenda)
+	assert.Equal("standup", key)
+
+	agenda, key = splitAgenda("reconcile-key: standup")
+	assert.Equal("", agenda)
+	assert.Equal("standup", key)
+
+	_, key = splitAgenda("Discuss reconcile-key: standup")
+	assert.Equal("", key)
+
+	_, key = splitAgenda("reconcile-key: standup\nmore notes")
+	assert.Equal("", key)
+}
+
+func TestReconciler_Plan(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeMeetings()
+	r := New(fake)
+
+	plan, err := r.Plan(context.Background(), loadTestConfig(t))
+	assert.NoError(err)
+	assert.True(plan.HasChanges())
+
+	assert.Len(plan.Changes, 2)
+	assert.Equal(ActionUpdate, plan.Changes[0].Action)
+	assert.Equal(int64(1), plan.Changes[0].MeetingID)
+	assert.Equal([]*Drift{{Field: "duration", Desired: "15", Current: "30"}}, plan.Changes[0].Drift)
+	assert.Equal(ActionCreate, plan.Changes[1].Action)
+
+	r.Prune = true
+
+	plan, err = r.Plan(context.Background(), loadTestConfig(t))
+	assert.NoError(err)
+	assert.Len(plan.Changes, 3)
+	assert.Equal(&Change{Action: ActionDelete, Key: "planning", MeetingID: 3}, plan.Changes[2])
+
+	buf := &bytes.Buffer{}
+	assert.NoError(WriteReport(buf, plan))
+	assert.Equal(`update standup (1)
+  duration: "30" => "15"
+create retro
+delete planning (3)
+`, buf.String())
+}
+
+func TestReconciler_Plan_NoDrift(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeMeetings()
+	fake.meetings[0].Duration = 15
+	fake.meetings = fake.meetings[:2]
+
+	cfg := loadTestConfig(t)
+	cfg.Meetings = cfg.Meetings[:1]
+	// The same days listed in another order are not drift.
+	cfg.Meetings[0].Recurrence.WeeklyDays = "6, 2,3,4,5,2"
+
+	plan, err := New(fake).Plan(context.Background(), cfg)
+
+	assert.NoError(err)
+	assert.False(plan.HasChanges())
+}
+
+func TestReconciler_Plan_TrackingField(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeMeetings()
+	fake.meetings[0].Agenda = "Daily sync"
+	fake.meetings[0].Duration = 15
+	fake.meetings[0].TrackingFields = []*zoom.MeetingsCreateResponseTrackingField{
+		{Field: "reconcile", Value: "standup"},
+	}
+
+	r := New(fake)
+	r.TrackingField = "reconcile"
+	r.Prune = true
+
+	plan, err := r.Plan(context.Background(), loadTestConfig(t))
+	assert.NoError(err)
+
+	// The agenda marker of meeting 3 is ignored when keys are held in a tracking field.
+	assert.Len(plan.Changes, 2)
+	assert.Equal(ActionNone, plan.Changes[0].Action)
+	assert.Equal(ActionCreate, plan.Changes[1].Action)
+
+	r.Apply(context.Background(), plan)
+
+	assert.Equal("", *fake.created.Agenda)
+	assert.Equal([]*zoom.MeetingsCreateResponseTrackingField{{Field: "reconcile", Value: "retro"}}, fake.created.TrackingFields)
+}
+
+func TestReconciler_Apply(t *testing.T) {
+	assert := assert.New(t)
+
+	fake := newFakeMeetings()
+	r := New(fake)
+	r.Prune = true
+
+	plan, err := r.Plan(context.Background(), loadTestConfig(t))
+	assert.NoError(err)
+
+	results := r.Apply(context.Background(), plan)
+
+	assert.Equal([]string{"update Team standup", "create host@example.com Retro", "delete"}, fake.calls)
+	assert.NoError(results[0].Err)
+	assert.NoError(results[1].Err)
+	assert.Equal(int64(1001), results[1].MeetingID)
+	assert.ErrorContains(results[2].Err, "deleting meeting: meeting already started")
+
+	assert.Nil(fake.updated.TrackingFields)
+	assert.Equal(15, *fake.updated.Duration)
+	assert.Equal(zoom.MeetingTypeRecurringFixed.Int(), *fake.updated.Type)
+	assert.Equal("2,3,4,5,6", *fake.updated.Recurrence.WeeklyDays)
+
+	assert.Equal("reconcile-key: retro", *fake.created.Agenda)
+	assert.Equal(zoom.MeetingTypeScheduled.Int(), *fake.created.Type)
+	assert.True(*fake.created.Settings.WaitingRoom)
+	assert.Equal(time.Date(2024, 1, 12, 20, 0, 0, 0, time.UTC), fake.created.StartTime.UTC())
+}
@@ -0,0 +1,274 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	RecordingsDeleteActionTrash  RecordingsDeleteAction = "trash"
+	RecordingsDeleteActionDelete RecordingsDeleteAction = "delete"
+
+	// recordingsMaxRangeDays is the longest from/to range accepted when listing a user's recordings.
+	recordingsMaxRangeDays = 30
+)
+
+// RecordingsDeleteAction indicates whether recordings are moved to the trash or permanently deleted.
+type RecordingsDeleteAction string
+
+func (r RecordingsDeleteAction) String() string {
+	return string(r)
+}
+
+type RecordingsServicer interface {
+	List(ctx context.Context, userID string, opts *RecordingsListOptions) (*RecordingsListResponse, *http.Response, error)
+	ListRange(ctx context.Context, userID string, from, to time.Time, opts *RecordingsListOptions) ([]*RecordingsMeeting, error)
+	Get(ctx context.Context, meetingID string, opts *RecordingsGetOptions) (*RecordingsMeeting, *http.Response, error)
+	Delete(ctx context.Context, meetingID string, opts *RecordingsDeleteOptions) (*http.Response, error)
+	DeleteFile(ctx context.Context, meetingID string, recordingID string, opts *RecordingsDeleteOptions) (*http.Response, error)
+	Recover(ctx context.Context, meetingID string) (*http.Response, error)
+	RecoverFile(ctx context.Context, meetingID string, recordingID string) (*http.Response, error)
+	GetSettings(ctx context.Context, meetingID string) (*RecordingsSettings, *http.Response, error)
+	UpdateSettings(ctx context.Context, meetingID string, opts *RecordingsSettingsUpdateOptions) (*http.Response, error)
+	Download(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error)
+}
+
+type RecordingsService struct {
+	client *Client
+}
+
+var _ RecordingsServicer = (*RecordingsService)(nil)
+
+type RecordingsListOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From      *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	MC        *string    `url:"mc,omitempty"`
+	MeetingID *int64     `url:"meeting_id,omitempty"`
+	To        *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+	Trash     *bool      `url:"trash,omitempty"`
+	TrashType *string    `url:"trash_type,omitempty"`
+}
+
+type RecordingsListResponse struct {
+	*PaginationResponse
+
+	From     string               `json:"from"`
+	Meetings []*RecordingsMeeting `json:"meetings"`
+	To       string               `json:"to"`
+}
+
+type RecordingsMeeting struct {
+	AccountID             string           `json:"account_id"`
+	DownloadAccessToken   string           `json:"download_access_token"`
+	Duration              int              `json:"duration"`
+	HostID                string           `json:"host_id"`
+	ID                    int64            `json:"id"`
+	Password              string           `json:"password"`
+	RecordingCount        int              `json:"recording_count"`
+	RecordingFiles        []*RecordingFile `json:"recording_files"`
+	RecordingPlayPasscode string           `json:"recording_play_passcode"`
+	ShareURL              string           `json:"share_url"`
+	StartTime             time.Time        `json:"start_time"`
+	Topic                 string           `json:"topic"`
+	TotalSize             int64            `json:"total_size"`
+	Type                  json.Number      `json:"type"`
+	UUID                  string           `json:"uuid"`
+}
+
+type RecordingFile struct {
+	DeletedTime    string    `json:"deleted_time"`
+	DownloadURL    string    `json:"download_url"`
+	FileExtension  string    `json:"file_extension"`
+	FilePath       string    `json:"file_path"`
+	FileSize       int64     `json:"file_size"`
+	FileType       string    `json:"file_type"`
+	ID             string    `json:"id"`
+	MeetingID      string    `json:"meeting_id"`
+	PlayURL        string    `json:"play_url"`
+	RecordingEnd   time.Time `json:"recording_end"`
+	RecordingStart time.Time `json:"recording_start"`
+	RecordingType  string    `json:"recording_type"`
+	Status         string    `json:"status"`
+}
+
+// escapeUUID escapes a meeting or webinar ID or UUID for use in a path. UUIDs that begin with a "/" or contain "//" must be double encoded.
+func escapeUUID(id string) string {
+	if strings.HasPrefix(id, "/") || strings.Contains(id, "//") {
+		id = url.QueryEscape(id)
+	}
+
+	return url.QueryEscape(id)
+}
+
+func meetingUUIDPath(meetingID string) string {
+	return "/meetings/" + escapeUUID(meetingID)
+}
+
+func (r *RecordingsService) List(ctx context.Context, userID string, opts *RecordingsListOptions) (*RecordingsListResponse, *http.Response, error) {
+	out := &RecordingsListResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/users/"+url.QueryEscape(userID)+"/recordings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListRange lists every recording of a user between from and to (inclusive). The range is split into Zoom's maximum window
+// and each window is paginated through, so any From, To and NextPageToken set on opts are ignored.
+func (r *RecordingsService) ListRange(ctx context.Context, userID string, from, to time.Time, opts *RecordingsListOptions) ([]*RecordingsMeeting, error) {
+	rangeOpts := &RecordingsListOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	meetings, err := listAllInRange(ctx, from, to, recordingsMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*RecordingsMeeting, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := r.List(ctx, userID, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Meetings, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing recordings: %w", err)
+	}
+
+	return meetings, nil
+}
+
+type RecordingsGetOptions struct {
+	IncludeFields *string `url:"include_fields,omitempty"`
+	TTL           *int    `url:"ttl,omitempty"`
+}
+
+// Get returns the recordings of a meeting. meetingID may be a meeting ID or a meeting instance UUID.
+func (r *RecordingsService) Get(ctx context.Context, meetingID string, opts *RecordingsGetOptions) (*RecordingsMeeting, *http.Response, error) {
+	out := &RecordingsMeeting{}
+
+	res, err := r.client.request(ctx, http.MethodGet, meetingUUIDPath(meetingID)+"/recordings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RecordingsDeleteOptions struct {
+	Action *RecordingsDeleteAction `url:"action,omitempty"`
+}
+
+func (r *RecordingsService) Delete(ctx context.Context, meetingID string, opts *RecordingsDeleteOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodDelete, meetingUUIDPath(meetingID)+"/recordings", opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *RecordingsService) DeleteFile(ctx context.Context, meetingID string, recordingID string, opts *RecordingsDeleteOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodDelete, meetingUUIDPath(meetingID)+"/recordings/"+url.QueryEscape(recordingID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type recordingsRecoverBody struct {
+	Action string `json:"action"`
+}
+
+// Recover restores all of a meeting's recordings from the trash.
+func (r *RecordingsService) Recover(ctx context.Context, meetingID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPut, meetingUUIDPath(meetingID)+"/recordings/status", nil, &recordingsRecoverBody{Action: "recover"}, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// RecoverFile restores a single recording file from the trash.
+func (r *RecordingsService) RecoverFile(ctx context.Context, meetingID string, recordingID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPut, meetingUUIDPath(meetingID)+"/recordings/"+url.QueryEscape(recordingID)+"/status", nil, &recordingsRecoverBody{Action: "recover"}, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type RecordingsSettings struct {
+	ApprovalType            int    `json:"approval_type"`
+	AuthenticationDomains   string `json:"authentication_domains"`
+	AuthenticationOption    string `json:"authentication_option"`
+	AutoDelete              bool   `json:"auto_delete"`
+	AutoDeleteDate          string `json:"auto_delete_date"`
+	OnDemand                bool   `json:"on_demand"`
+	Password                string `json:"password"`
+	RecordingAuthentication bool   `json:"recording_authentication"`
+	SendEmailToHost         bool   `json:"send_email_to_host"`
+	ShareRecording          string `json:"share_recording"`
+	ShowSocialShareButtons  bool   `json:"show_social_share_buttons"`
+	Topic                   string `json:"topic"`
+	ViewerDownload          bool   `json:"viewer_download"`
+}
+
+func (r *RecordingsService) GetSettings(ctx context.Context, meetingID string) (*RecordingsSettings, *http.Response, error) {
+	out := &RecordingsSettings{}
+
+	res, err := r.client.request(ctx, http.MethodGet, meetingUUIDPath(meetingID)+"/recordings/settings", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RecordingsSettingsUpdateOptions struct {
+	ApprovalType            *int    `json:"approval_type,omitempty"`
+	AuthenticationDomains   *string `json:"authentication_domains,omitempty"`
+	AuthenticationOption    *string `json:"authentication_option,omitempty"`
+	AutoDelete              *bool   `json:"auto_delete,omitempty"`
+	OnDemand                *bool   `json:"on_demand,omitempty"`
+	Password                *string `json:"password,omitempty"`
+	RecordingAuthentication *bool   `json:"recording_authentication,omitempty"`
+	SendEmailToHost         *bool   `json:"send_email_to_host,omitempty"`
+	ShareRecording          *string `json:"share_recording,omitempty"`
+	ShowSocialShareButtons  *bool   `json:"show_social_share_buttons,omitempty"`
+	Topic                   *string `json:"topic,omitempty"`
+	ViewerDownload          *bool   `json:"viewer_download,omitempty"`
+}
+
+func (r *RecordingsService) UpdateSettings(ctx context.Context, meetingID string, opts *RecordingsSettingsUpdateOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, meetingUUIDPath(meetingID)+"/recordings/settings", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// Download streams a recording file's DownloadURL into w. Use DownloadOptions to resume a partial download and to verify its size or checksum.
+func (r *RecordingsService) Download(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error) {
+	n, res, err := r.client.download(ctx, downloadURL, w, opts)
+	if err != nil {
+		return n, res, fmt.Errorf("downloading recording: %w", err)
+	}
+
+	return n, res, nil
+}
@@ -0,0 +1,389 @@
+package zoom
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RecurrenceTypeDaily   RecurrenceType = 1
+	RecurrenceTypeWeekly  RecurrenceType = 2
+	RecurrenceTypeMonthly RecurrenceType = 3
+
+	MonthlyWeekLast   MonthlyWeek = -1
+	MonthlyWeekFirst  MonthlyWeek = 1
+	MonthlyWeekSecond MonthlyWeek = 2
+	MonthlyWeekThird  MonthlyWeek = 3
+	MonthlyWeekFourth MonthlyWeek = 4
+
+	// Limits Zoom places on recurring meetings.
+	RecurrenceMaxDailyInterval   = 99
+	RecurrenceMaxWeeklyInterval  = 50
+	RecurrenceMaxMonthlyInterval = 10
+	RecurrenceMaxOccurrences     = 60
+)
+
+var ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+type RecurrenceType int
+
+func (r RecurrenceType) Int() int {
+	return int(r)
+}
+
+// MonthlyWeek is the week of the month of a monthly meeting that recurs on a weekday, such as the last Friday.
+type MonthlyWeek int
+
+func (m MonthlyWeek) Int() int {
+	return int(m)
+}
+
+// Recurrence is a typed form of Zoom's recurrence object. Build one with DailyRecurrence, WeeklyRecurrence,
+// MonthlyDayRecurrence or MonthlyWeekdayRecurrence, optionally ending it with Times or Until.
+type Recurrence struct {
+	Type     RecurrenceType
+	Interval int
+	// Weekdays are the days a weekly meeting recurs on.
+	Weekdays []time.Weekday
+	// MonthlyDay is the day of the month a monthly meeting recurs on, unless MonthlyWeek is set.
+	MonthlyDay int
+	// MonthlyWeek and MonthlyWeekday are the week and weekday of the month a monthly meeting recurs on.
+	MonthlyWeek    MonthlyWeek
+	MonthlyWeekday time.Weekday
+	// EndTimes is the number of occurrences, and EndDateTime the date of the last occurrence. At most one can be set.
+	EndTimes    int
+	EndDateTime time.Time
+}
+
+// DailyRecurrence recurs every interval days.
+func DailyRecurrence(interval int) *Recurrence {
+	return &Recurrence{
+		Type:     RecurrenceTypeDaily,
+		Interval: interval,
+	}
+}
+
+// WeeklyRecurrence recurs on the given weekdays every interval weeks.
+func WeeklyRecurrence(interval int, weekdays ...time.Weekday) *Recurrence {
+	return &Recurrence{
+		Type:     RecurrenceTypeWeekly,
+		Interval: interval,
+		Weekdays: weekdays,
+	}
+}
+
+// MonthlyDayRecurrence recurs on a day of the month every interval months.
+func MonthlyDayRecurrence(interval int, day int) *Recurrence {
+	return &Recurrence{
+		Type:       RecurrenceTypeMonthly,
+		Interval:   interval,
+		MonthlyDay: day,
+	}
+}
+
+// MonthlyWeekdayRecurrence recurs on a weekday of a week of the month, such as the last Friday, every interval months.
+func MonthlyWeekdayRecurrence(interval int, week MonthlyWeek, weekday time.Weekday) *Recurrence {
+	return &Recurrence{
+		Type:           RecurrenceTypeMonthly,
+		Interval:       interval,
+		MonthlyWeek:    week,
+		MonthlyWeekday: weekday,
+	}
+}
+
+// Times ends the recurrence after n occurrences.
+func (r *Recurrence) Times(n int) *Recurrence {
+	r.EndTimes = n
+	r.EndDateTime = time.Time{}
+
+	return r
+}
+
+// Until ends the recurrence with the last occurrence on or before the date of t.
+func (r *Recurrence) Until(t time.Time) *Recurrence {
+	r.EndDateTime = t
+	r.EndTimes = 0
+
+	return r
+}
+
+// Validate checks the recurrence against the limits Zoom accepts.
+func (r *Recurrence) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidRecurrence, fmt.Sprintf(format, args...))
+	}
+
+	var maxInterval int
+	switch r.Type {
+	case RecurrenceTypeDaily:
+		maxInterval = RecurrenceMaxDailyInterval
+	case RecurrenceTypeWeekly:
+		maxInterval = RecurrenceMaxWeeklyInterval
+
+		if len(r.Weekdays) == 0 {
+			return invalid("weekly recurrence needs at least one weekday")
+		}
+
+		for _, weekday := range r.Weekdays {
+			if weekday < time.Sunday || weekday > time.Saturday {
+				return invalid("weekday %d out of range", weekday)
+			}
+		}
+	case RecurrenceTypeMonthly:
+		maxInterval = RecurrenceMaxMonthlyInterval
+
+		if r.MonthlyWeek == 0 {
+			if r.MonthlyDay < 1 || r.MonthlyDay > 31 {
+				return invalid("monthly day must be between 1 and 31")
+			}
+		} else {
+			if r.MonthlyWeek != MonthlyWeekLast && (r.MonthlyWeek < MonthlyWeekFirst || r.MonthlyWeek > MonthlyWeekFourth) {
+				return invalid("monthly week must be -1 or between 1 and 4")
+			}
+
+			if r.MonthlyWeekday < time.Sunday || r.MonthlyWeekday > time.Saturday {
+				return invalid("monthly weekday %d out of range", r.MonthlyWeekday)
+			}
+
+			if r.MonthlyDay != 0 {
+				return invalid("monthly day cannot be combined with monthly week")
+			}
+		}
+	default:
+		return invalid("unknown type %d", r.Type)
+	}
+
+	if r.Interval < 1 || r.Interval > maxInterval {
+		return invalid("interval must be between 1 and %d", maxInterval)
+	}
+
+	if r.EndTimes < 0 || r.EndTimes > RecurrenceMaxOccurrences {
+		return invalid("end times must be between 1 and %d", RecurrenceMaxOccurrences)
+	}
+
+	if r.EndTimes > 0 && !r.EndDateTime.IsZero() {
+		return invalid("end times and end date time cannot both be set")
+	}
+
+	return nil
+}
+
+// Options validates the recurrence and converts it into the recurrence object sent when creating meetings and webinars.
+func (r *Recurrence) Options() (*MeetingsCreateOptionsRecurrence, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &MeetingsCreateOptionsRecurrence{
+		RepeatInterval: Ptr(r.Interval),
+		Type:           r.Type.Int(),
+	}
+
+	switch {
+	case r.Type == RecurrenceTypeWeekly:
+		opts.WeeklyDays = Ptr(formatWeekdays(r.Weekdays))
+	case r.Type == RecurrenceTypeMonthly && r.MonthlyWeek != 0:
+		opts.MonthlyWeek = Ptr(r.MonthlyWeek.Int())
+		opts.MonthlyWeekDay = Ptr(zoomWeekday(r.MonthlyWeekday))
+	case r.Type == RecurrenceTypeMonthly:
+		opts.MonthlyDay = Ptr(r.MonthlyDay)
+	}
+
+	if r.EndTimes > 0 {
+		opts.EndTimes = Ptr(r.EndTimes)
+	}
+
+	if !r.EndDateTime.IsZero() {
+		opts.EndDateTime = Ptr(r.EndDateTime.UTC())
+	}
+
+	return opts, nil
+}
+
+// Recurrence interprets the raw recurrence object returned by Zoom.
+func (m *MeetingsCreateResponseRecurrance) Recurrence() (*Recurrence, error) {
+	r := &Recurrence{
+		Type:        RecurrenceType(m.Type),
+		Interval:    max(m.RepeatInterval, 1),
+		MonthlyDay:  m.MonthlyDay,
+		MonthlyWeek: MonthlyWeek(m.MonthlyWeek),
+		EndTimes:    m.EndTimes,
+		EndDateTime: m.EndDateTime,
+	}
+
+	if r.MonthlyWeek != 0 {
+		r.MonthlyDay = 0
+		r.MonthlyWeekday = time.Weekday(m.MonthlyWeekDay - 1)
+	}
+
+	if len(m.WeeklyDays) > 0 {
+		var err error
+		r.Weekdays, err = parseWeekdays(m.WeeklyDays)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Zoom reports both ends on occasion, in which case the number of occurrences is the one in effect.
+	if r.EndTimes > 0 {
+		r.EndDateTime = time.Time{}
+	}
+
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Occurrences expands the recurrence from the first occurrence at start, keeping start's wall clock time in the
+// meeting's timezone, or UTC when it has none, across daylight saving time changes. Recurrences without an end are
+// expanded to RecurrenceMaxOccurrences occurrences. Monthly meetings skip months without their day, such as the 31st.
+func (r *Recurrence) Occurrences(start time.Time, timezone string) ([]time.Time, error) {
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if len(timezone) > 0 {
+		loc, err = LoadTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start = start.In(loc)
+
+	limit := RecurrenceMaxOccurrences
+	if r.EndTimes > 0 {
+		limit = r.EndTimes
+	}
+
+	var lastDay time.Time
+	if !r.EndDateTime.IsZero() {
+		end := r.EndDateTime.In(loc)
+		lastDay = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	}
+
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, loc)
+	}
+
+	var occurrences []time.Time
+	add := func(t time.Time) bool {
+		if t.Before(start) {
+			return true
+		}
+
+		if !lastDay.IsZero() && time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).After(lastDay) {
+			return false
+		}
+
+		occurrences = append(occurrences, t)
+
+		return len(occurrences) < limit
+	}
+
+	switch r.Type {
+	case RecurrenceTypeDaily:
+		for i := 0; ; i += r.Interval {
+			if !add(at(start.Year(), start.Month(), start.Day()+i)) {
+				break
+			}
+		}
+	case RecurrenceTypeWeekly:
+		weekdays := slices.Clone(r.Weekdays)
+		slices.Sort(weekdays)
+		weekdays = slices.Compact(weekdays)
+
+		// Weeks start on Sunday.
+		sunday := start.Day() - int(start.Weekday())
+
+	weeks:
+		for week := 0; ; week += r.Interval {
+			for _, weekday := range weekdays {
+				if !add(at(start.Year(), start.Month(), sunday+week*7+int(weekday))) {
+					break weeks
+				}
+			}
+		}
+	case RecurrenceTypeMonthly:
+		// A monthly day that no month has would never end a recurrence without an end date, so stop after a year of
+		// months without occurrences.
+		for i, empty := 0, 0; empty < 12*r.Interval; i += r.Interval {
+			first := at(start.Year(), start.Month()+time.Month(i), 1)
+
+			var day int
+			if r.MonthlyWeek == 0 {
+				day = r.MonthlyDay
+			} else {
+				day = monthlyWeekday(first, r.MonthlyWeek, r.MonthlyWeekday)
+			}
+
+			t := at(first.Year(), first.Month(), day)
+			if t.Month() != first.Month() {
+				empty += r.Interval
+				continue
+			}
+			empty = 0
+
+			if !add(t) {
+				break
+			}
+		}
+	}
+
+	return occurrences, nil
+}
+
+// monthlyWeekday returns the day of the month of the given week and weekday in the month starting on first.
+func monthlyWeekday(first time.Time, week MonthlyWeek, weekday time.Weekday) int {
+	day := 1 + (int(weekday)-int(first.Weekday())+7)%7
+
+	if week != MonthlyWeekLast {
+		return day + (week.Int()-1)*7
+	}
+
+	daysInMonth := time.Date(first.Year(), first.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for day+7 <= daysInMonth {
+		day += 7
+	}
+
+	return day
+}
+
+// zoomWeekday converts a weekday into Zoom's numbering, from 1 for Sunday to 7 for Saturday.
+func zoomWeekday(weekday time.Weekday) int {
+	return int(weekday) + 1
+}
+
+func formatWeekdays(weekdays []time.Weekday) string {
+	days := make([]string, len(weekdays))
+	for i, weekday := range weekdays {
+		days[i] = strconv.Itoa(zoomWeekday(weekday))
+	}
+
+	return strings.Join(days, ",")
+}
+
+func parseWeekdays(s string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+
+	for _, day := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(day))
+		if err != nil || n < 1 || n > 7 {
+			return nil, fmt.Errorf("%w: weekly day %q", ErrInvalidRecurrence, day)
+		}
+
+		weekdays = append(weekdays, time.Weekday(n-1))
+	}
+
+	return weekdays, nil
+}
@@ -0,0 +1,149 @@
+package zoom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrence_Options(t *testing.T) {
+	assert := assert.New(t)
+
+	opts, err := WeeklyRecurrence(2, time.Monday, time.Friday).Times(10).Options()
+	assert.NoError(err)
+
+	b, err := json.Marshal(opts)
+	assert.NoError(err)
+	assert.JSONEq(`{"type": 2, "repeat_interval": 2, "weekly_days": "2,6", "end_times": 10}`, string(b))
+
+	opts, err = MonthlyWeekdayRecurrence(1, MonthlyWeekLast, time.Friday).Until(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)).Options()
+	assert.NoError(err)
+
+	b, err = json.Marshal(opts)
+	assert.NoError(err)
+	assert.JSONEq(`{"type": 3, "repeat_interval": 1, "monthly_week": -1, "monthly_week_day": 6, "end_date_time": "2024-12-31T00:00:00Z"}`, string(b))
+
+	opts, err = MonthlyDayRecurrence(3, 15).Options()
+	assert.NoError(err)
+	assert.Equal(15, *opts.MonthlyDay)
+	assert.Nil(opts.MonthlyWeek)
+}
+
+func TestRecurrence_Validate(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, r := range map[string]*Recurrence{
+		"daily interval":      DailyRecurrence(100),
+		"weekly interval":     WeeklyRecurrence(51, time.Monday),
+		"monthly interval":    MonthlyDayRecurrence(11, 1),
+		"zero interval":       DailyRecurrence(0),
+		"no weekdays":         WeeklyRecurrence(1),
+		"weekday":             WeeklyRecurrence(1, time.Weekday(7)),
+		"monthly day":         MonthlyDayRecurrence(1, 32),
+		"monthly week":        MonthlyWeekdayRecurrence(1, 5, time.Monday),
+		"too many times":      DailyRecurrence(1).Times(RecurrenceMaxOccurrences + 1),
+		"both ends":           {Type: RecurrenceTypeDaily, Interval: 1, EndTimes: 2, EndDateTime: time.Now()},
+		"unknown type":        {Type: 4, Interval: 1},
+		"monthly day or week": {Type: RecurrenceTypeMonthly, Interval: 1, MonthlyDay: 1, MonthlyWeek: MonthlyWeekFirst},
+	} {
+		assert.ErrorIs(r.Validate(), ErrInvalidRecurrence, name)
+	}
+
+	assert.NoError(DailyRecurrence(RecurrenceMaxDailyInterval).Times(RecurrenceMaxOccurrences).Validate())
+}
+
+func TestMeetingsCreateResponseRecurrance_Recurrence(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := (&MeetingsCreateResponseRecurrance{Type: 2, RepeatInterval: 1, WeeklyDays: "1,7", EndTimes: 4}).Recurrence()
+	assert.NoError(err)
+	assert.Equal(WeeklyRecurrence(1, time.Sunday, time.Saturday).Times(4), r)
+
+	r, err = (&MeetingsCreateResponseRecurrance{Type: 3, MonthlyWeek: 2, MonthlyWeekDay: 3, MonthlyDay: 1}).Recurrence()
+	assert.NoError(err)
+	assert.Equal(MonthlyWeekdayRecurrence(1, MonthlyWeekSecond, time.Tuesday), r)
+
+	_, err = (&MeetingsCreateResponseRecurrance{Type: 2, WeeklyDays: "2,x"}).Recurrence()
+	assert.ErrorIs(err, ErrInvalidRecurrence)
+}
+
+func formatOccurrences(occurrences []time.Time) []string {
+	var s []string
+	for _, o := range occurrences {
+		s = append(s, o.Format("2006-01-02 15:04 MST"))
+	}
+
+	return s
+}
+
+func TestRecurrence_Occurrences_Daily(t *testing.T) {
+	assert := assert.New(t)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	start := time.Date(2024, 3, 8, 9, 30, 0, 0, loc)
+
+	// The wall clock time is kept across the start of daylight saving time on March 10.
+	occurrences, err := DailyRecurrence(2).Times(3).Occurrences(start.UTC(), "America/New_York")
+
+	assert.NoError(err)
+	assert.Equal([]string{"2024-03-08 09:30 EST", "2024-03-10 09:30 EDT", "2024-03-12 09:30 EDT"}, formatOccurrences(occurrences))
+}
+
+func TestRecurrence_Occurrences_Weekly(t *testing.T) {
+	assert := assert.New(t)
+
+	// Wednesday January 3rd.
+	start := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	occurrences, err := WeeklyRecurrence(2, time.Friday, time.Monday).Until(time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)).Occurrences(start, "UTC")
+
+	assert.NoError(err)
+	assert.Equal([]string{
+		"2024-01-05 10:00 UTC",
+		"2024-01-15 10:00 UTC",
+		"2024-01-19 10:00 UTC",
+		"2024-01-29 10:00 UTC",
+	}, formatOccurrences(occurrences))
+}
+
+func TestRecurrence_Occurrences_Monthly(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC)
+
+	occurrences, err := MonthlyDayRecurrence(1, 31).Times(3).Occurrences(start, "UTC")
+	assert.NoError(err)
+	assert.Equal([]string{"2024-01-31 08:00 UTC", "2024-03-31 08:00 UTC", "2024-05-31 08:00 UTC"}, formatOccurrences(occurrences))
+
+	occurrences, err = MonthlyWeekdayRecurrence(1, MonthlyWeekLast, time.Friday).Times(3).Occurrences(start, "UTC")
+	assert.NoError(err)
+	assert.Equal([]string{"2024-02-23 08:00 UTC", "2024-03-29 08:00 UTC", "2024-04-26 08:00 UTC"}, formatOccurrences(occurrences))
+
+	occurrences, err = MonthlyWeekdayRecurrence(2, MonthlyWeekFirst, time.Monday).Times(2).Occurrences(start, "UTC")
+	assert.NoError(err)
+	assert.Equal([]string{"2024-03-04 08:00 UTC", "2024-05-06 08:00 UTC"}, formatOccurrences(occurrences))
+}
+
+func TestRecurrence_Occurrences_Limit(t *testing.T) {
+	assert := assert.New(t)
+
+	occurrences, err := DailyRecurrence(1).Occurrences(time.Now(), "UTC")
+	assert.NoError(err)
+	assert.Len(occurrences, RecurrenceMaxOccurrences)
+
+	_, err = DailyRecurrence(1).Occurrences(time.Now(), "Mars/Olympus")
+	assert.ErrorIs(err, ErrUnsupportedTimezone)
+}
+
+func TestRecurrence_Occurrences_ZoomTimezone(t *testing.T) {
+	assert := assert.New(t)
+
+	// Zoom lists SST as the Solomon Islands, which is not an IANA timezone.
+	start := time.Date(2024, 1, 7, 22, 30, 0, 0, time.UTC)
+	occurrences, err := DailyRecurrence(1).Times(2).Occurrences(start, "SST")
+
+	assert.NoError(err)
+	assert.Equal([]string{"2024-01-08 09:30 +11", "2024-01-09 09:30 +11"}, formatOccurrences(occurrences))
+}
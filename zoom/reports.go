@@ -0,0 +1,521 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	ReportUsersTypeActive   = "active"
+	ReportUsersTypeInactive = "inactive"
+
+	// reportsMaxRangeDays is the longest from/to range accepted by the report endpoints.
+	reportsMaxRangeDays = 30
+)
+
+type ReportsServicer interface {
+	Daily(ctx context.Context, opts *ReportsDailyOptions) (*ReportsDailyResponse, *http.Response, error)
+	Users(ctx context.Context, opts *ReportsUsersOptions) (*ReportsUsersResponse, *http.Response, error)
+	UsersRange(ctx context.Context, from, to time.Time, opts *ReportsUsersOptions) ([]*ReportsUser, error)
+	MeetingDetails(ctx context.Context, meetingID string) (*ReportsMeetingDetails, *http.Response, error)
+	MeetingParticipants(ctx context.Context, meetingID string, opts *ReportsParticipantsOptions) (*ReportsParticipantsResponse, *http.Response, error)
+	MeetingParticipantsAll(ctx context.Context, meetingID string, opts *ReportsParticipantsOptions) ([]*ReportsParticipant, error)
+	WebinarDetails(ctx context.Context, webinarID string) (*ReportsMeetingDetails, *http.Response, error)
+	WebinarParticipants(ctx context.Context, webinarID string, opts *ReportsParticipantsOptions) (*ReportsParticipantsResponse, *http.Response, error)
+	WebinarParticipantsAll(ctx context.Context, webinarID string, opts *ReportsParticipantsOptions) ([]*ReportsParticipant, error)
+	CloudRecording(ctx context.Context, opts *ReportsCloudRecordingOptions) (*ReportsCloudRecordingResponse, *http.Response, error)
+	CloudRecordingRange(ctx context.Context, from, to time.Time) ([]*ReportsCloudRecordingStorage, error)
+	Telephone(ctx context.Context, opts *ReportsTelephoneOptions) (*ReportsTelephoneResponse, *http.Response, error)
+	TelephoneRange(ctx context.Context, from, to time.Time, opts *ReportsTelephoneOptions) ([]*ReportsTelephonyUsage, error)
+	Activities(ctx context.Context, opts *ReportsActivitiesOptions) (*ReportsActivitiesResponse, *http.Response, error)
+	ActivitiesRange(ctx context.Context, from, to time.Time, opts *ReportsActivitiesOptions) ([]*ReportsActivityLog, error)
+	OperationLogs(ctx context.Context, opts *ReportsOperationLogsOptions) (*ReportsOperationLogsResponse, *http.Response, error)
+	OperationLogsRange(ctx context.Context, from, to time.Time, opts *ReportsOperationLogsOptions) ([]*ReportsOperationLog, error)
+}
+
+// ReportsService covers the report endpoints. Methods suffixed with Range split a longer period into the maximum range
+// Zoom accepts and follow pagination, returning every item; methods suffixed with All follow pagination only.
+type ReportsService struct {
+	client *Client
+}
+
+var _ ReportsServicer = (*ReportsService)(nil)
+
+type ReportsDailyOptions struct {
+	Month *int `url:"month,omitempty"`
+	Year  *int `url:"year,omitempty"`
+}
+
+type ReportsDailyResponse struct {
+	Dates []*ReportsDailyDate `json:"dates"`
+	Month int                 `json:"month"`
+	Year  int                 `json:"year"`
+}
+
+type ReportsDailyDate struct {
+	Date           string `json:"date"`
+	MeetingMinutes int    `json:"meeting_minutes"`
+	Meetings       int    `json:"meetings"`
+	NewUsers       int    `json:"new_users"`
+	Participants   int    `json:"participants"`
+}
+
+func (r *ReportsService) Daily(ctx context.Context, opts *ReportsDailyOptions) (*ReportsDailyResponse, *http.Response, error) {
+	out := &ReportsDailyResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/daily", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ReportsUsersOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To   *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+	Type *string    `url:"type,omitempty"`
+}
+
+type ReportsUsersResponse struct {
+	*PaginationResponse
+
+	From                string         `json:"from"`
+	To                  string         `json:"to"`
+	TotalMeetingMinutes int            `json:"total_meeting_minutes"`
+	TotalMeetings       int            `json:"total_meetings"`
+	TotalParticipants   int            `json:"total_participants"`
+	Users               []*ReportsUser `json:"users"`
+}
+
+type ReportsUser struct {
+	CreateTime        time.Time                       `json:"create_time"`
+	CustomAttributes  []*UsersListItemCustomAttribute `json:"custom_attributes"`
+	Dept              string                          `json:"dept"`
+	Email             string                          `json:"email"`
+	ID                string                          `json:"id"`
+	LastClientVersion string                          `json:"last_client_version"`
+	LastLoginTime     time.Time                       `json:"last_login_time"`
+	MeetingMinutes    int                             `json:"meeting_minutes"`
+	Meetings          int                             `json:"meetings"`
+	Participants      int                             `json:"participants"`
+	Type              int                             `json:"type"`
+	UserName          string                          `json:"user_name"`
+}
+
+// Users reports active or inactive hosts, depending on the Type option.
+func (r *ReportsService) Users(ctx context.Context, opts *ReportsUsersOptions) (*ReportsUsersResponse, *http.Response, error) {
+	out := &ReportsUsersResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/users", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// UsersRange reports hosts between from and to. Usage is reported per window, so a host active in more than one window appears once per window.
+func (r *ReportsService) UsersRange(ctx context.Context, from, to time.Time, opts *ReportsUsersOptions) ([]*ReportsUser, error) {
+	rangeOpts := &ReportsUsersOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	users, err := listAllInRange(ctx, from, to, reportsMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*ReportsUser, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := r.Users(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Users, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reporting users: %w", err)
+	}
+
+	return users, nil
+}
+
+// ReportsMeetingDetails is returned for both meeting and webinar detail reports.
+type ReportsMeetingDetails struct {
+	CustomKeys        []*MeetingsCreateResponseSettingsCustomKey `json:"custom_keys"`
+	Dept              string                                     `json:"dept"`
+	Duration          int                                        `json:"duration"`
+	EndTime           time.Time                                  `json:"end_time"`
+	ID                int64                                      `json:"id"`
+	ParticipantsCount int                                        `json:"participants_count"`
+	StartTime         time.Time                                  `json:"start_time"`
+	Topic             string                                     `json:"topic"`
+	TotalMinutes      int                                        `json:"total_minutes"`
+	TrackingFields    []*MeetingsCreateResponseTrackingField     `json:"tracking_fields"`
+	Type              int                                        `json:"type"`
+	UserEmail         string                                     `json:"user_email"`
+	UserName          string                                     `json:"user_name"`
+	UUID              string                                     `json:"uuid"`
+}
+
+// MeetingDetails reports a past meeting. meetingID may be a meeting ID or a meeting instance UUID.
+func (r *ReportsService) MeetingDetails(ctx context.Context, meetingID string) (*ReportsMeetingDetails, *http.Response, error) {
+	out := &ReportsMeetingDetails{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/meetings/"+escapeUUID(meetingID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type ReportsParticipantsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	IncludeFields *string `url:"include_fields,omitempty"`
+}
+
+type ReportsParticipantsResponse struct {
+	*PaginationResponse
+
+	Participants []*ReportsParticipant `json:"participants"`
+}
+
+type ReportsParticipant struct {
+	CustomerKey       string    `json:"customer_key"`
+	Duration          int       `json:"duration"`
+	Failover          bool      `json:"failover"`
+	ID                string    `json:"id"`
+	JoinTime          time.Time `json:"join_time"`
+	LeaveTime         time.Time `json:"leave_time"`
+	Name              string    `json:"name"`
+	ParticipantUserID string    `json:"participant_user_id"`
+	RegistrantID      string    `json:"registrant_id"`
+	Status            string    `json:"status"`
+	UserEmail         string    `json:"user_email"`
+	UserID            string    `json:"user_id"`
+}
+
+// MeetingParticipants reports the participants of a past meeting. meetingID may be a meeting ID or a meeting instance UUID.
+func (r *ReportsService) MeetingParticipants(ctx context.Context, meetingID string, opts *ReportsParticipantsOptions) (*ReportsParticipantsResponse, *http.Response, error) {
+	out := &ReportsParticipantsResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/meetings/"+escapeUUID(meetingID)+"/participants", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *ReportsService) MeetingParticipantsAll(ctx context.Context, meetingID string, opts *ReportsParticipantsOptions) ([]*ReportsParticipant, error) {
+	return r.participantsAll(ctx, opts, func(pageOpts *ReportsParticipantsOptions) (*ReportsParticipantsResponse, error) {
+		out, _, err := r.MeetingParticipants(ctx, meetingID, pageOpts)
+		return out, err
+	})
+}
+
+// WebinarDetails reports a past webinar. webinarID may be a webinar ID or a webinar instance UUID.
+func (r *ReportsService) WebinarDetails(ctx context.Context, webinarID string) (*ReportsMeetingDetails, *http.Response, error) {
+	out := &ReportsMeetingDetails{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/webinars/"+escapeUUID(webinarID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// WebinarParticipants reports the attendees of a past webinar. webinarID may be a webinar ID or a webinar instance UUID.
+func (r *ReportsService) WebinarParticipants(ctx context.Context, webinarID string, opts *ReportsParticipantsOptions) (*ReportsParticipantsResponse, *http.Response, error) {
+	out := &ReportsParticipantsResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/webinars/"+escapeUUID(webinarID)+"/participants", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *ReportsService) WebinarParticipantsAll(ctx context.Context, webinarID string, opts *ReportsParticipantsOptions) ([]*ReportsParticipant, error) {
+	return r.participantsAll(ctx, opts, func(pageOpts *ReportsParticipantsOptions) (*ReportsParticipantsResponse, error) {
+		out, _, err := r.WebinarParticipants(ctx, webinarID, pageOpts)
+		return out, err
+	})
+}
+
+func (r *ReportsService) participantsAll(ctx context.Context, opts *ReportsParticipantsOptions, fn func(*ReportsParticipantsOptions) (*ReportsParticipantsResponse, error)) ([]*ReportsParticipant, error) {
+	pageOpts := &ReportsParticipantsOptions{}
+	if opts != nil {
+		*pageOpts = *opts
+	}
+
+	var participants []*ReportsParticipant
+
+	err := listAll(ctx, pageOpts.PaginationOptions, func(page *PaginationOptions) (*PaginationResponse, error) {
+		pageOpts.PaginationOptions = page
+
+		out, err := fn(pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		participants = append(participants, out.Participants...)
+
+		return out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reporting participants: %w", err)
+	}
+
+	return participants, nil
+}
+
+type ReportsCloudRecordingOptions struct {
+	From *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To   *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+type ReportsCloudRecordingResponse struct {
+	CloudRecordingStorage []*ReportsCloudRecordingStorage `json:"cloud_recording_storage"`
+	From                  string                          `json:"from"`
+	To                    string                          `json:"to"`
+}
+
+type ReportsCloudRecordingStorage struct {
+	Date      string `json:"date"`
+	FreeUsage string `json:"free_usage"`
+	PlanUsage string `json:"plan_usage"`
+	Usage     string `json:"usage"`
+}
+
+func (r *ReportsService) CloudRecording(ctx context.Context, opts *ReportsCloudRecordingOptions) (*ReportsCloudRecordingResponse, *http.Response, error) {
+	out := &ReportsCloudRecordingResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/cloud_recording", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *ReportsService) CloudRecordingRange(ctx context.Context, from, to time.Time) ([]*ReportsCloudRecordingStorage, error) {
+	storage, err := listAllInRange(ctx, from, to, reportsMaxRangeDays, nil, func(dr DateRange, page *PaginationOptions) ([]*ReportsCloudRecordingStorage, *PaginationResponse, error) {
+		out, _, err := r.CloudRecording(ctx, &ReportsCloudRecordingOptions{
+			From: Ptr(dr.From),
+			To:   Ptr(dr.To),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.CloudRecordingStorage, nil, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reporting cloud recording usage: %w", err)
+	}
+
+	return storage, nil
+}
+
+type ReportsTelephoneOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From          *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	QueryDateType *string    `url:"query_date_type,omitempty"`
+	To            *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+	Type          *string    `url:"type,omitempty"`
+}
+
+type ReportsTelephoneResponse struct {
+	*PaginationResponse
+
+	From           string                   `json:"from"`
+	TelephonyUsage []*ReportsTelephonyUsage `json:"telephony_usage"`
+	To             string                   `json:"to"`
+}
+
+type ReportsTelephonyUsage struct {
+	CallInNumber   string    `json:"call_in_number"`
+	CountryName    string    `json:"country_name"`
+	Dept           string    `json:"dept"`
+	Duration       int       `json:"duration"`
+	EndTime        time.Time `json:"end_time"`
+	HostEmail      string    `json:"host_email"`
+	HostName       string    `json:"host_name"`
+	MeetingID      int64     `json:"meeting_id"`
+	MeetingType    string    `json:"meeting_type"`
+	PhoneNumber    string    `json:"phone_number"`
+	Rate           float64   `json:"rate"`
+	SignaledNumber string    `json:"signaled_number"`
+	StartTime      time.Time `json:"start_time"`
+	Total          float64   `json:"total"`
+	Type           string    `json:"type"`
+	UUID           string    `json:"uuid"`
+}
+
+func (r *ReportsService) Telephone(ctx context.Context, opts *ReportsTelephoneOptions) (*ReportsTelephoneResponse, *http.Response, error) {
+	out := &ReportsTelephoneResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/telephone", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *ReportsService) TelephoneRange(ctx context.Context, from, to time.Time, opts *ReportsTelephoneOptions) ([]*ReportsTelephonyUsage, error) {
+	rangeOpts := &ReportsTelephoneOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	usage, err := listAllInRange(ctx, from, to, reportsMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*ReportsTelephonyUsage, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := r.Telephone(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.TelephonyUsage, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reporting telephone usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+type ReportsActivitiesOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To   *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+type ReportsActivitiesResponse struct {
+	*PaginationResponse
+
+	ActivityLogs []*ReportsActivityLog `json:"activity_logs"`
+	From         string                `json:"from"`
+	To           string                `json:"to"`
+}
+
+type ReportsActivityLog struct {
+	ClientType string    `json:"client_type"`
+	Email      string    `json:"email"`
+	IPAddress  string    `json:"ip_address"`
+	Time       time.Time `json:"time"`
+	Type       string    `json:"type"`
+	Version    string    `json:"version"`
+}
+
+// Activities reports user sign-in and sign-out activity.
+func (r *ReportsService) Activities(ctx context.Context, opts *ReportsActivitiesOptions) (*ReportsActivitiesResponse, *http.Response, error) {
+	out := &ReportsActivitiesResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/activities", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *ReportsService) ActivitiesRange(ctx context.Context, from, to time.Time, opts *ReportsActivitiesOptions) ([]*ReportsActivityLog, error) {
+	rangeOpts := &ReportsActivitiesOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	logs, err := listAllInRange(ctx, from, to, reportsMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*ReportsActivityLog, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := r.Activities(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.ActivityLogs, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reporting activities: %w", err)
+	}
+
+	return logs, nil
+}
+
+type ReportsOperationLogsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	CategoryType *string    `url:"category_type,omitempty"`
+	From         *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To           *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+}
+
+type ReportsOperationLogsResponse struct {
+	*PaginationResponse
+
+	From          string                 `json:"from"`
+	OperationLogs []*ReportsOperationLog `json:"operation_logs"`
+	To            string                 `json:"to"`
+}
+
+type ReportsOperationLog struct {
+	Action          string    `json:"action"`
+	CategoryType    string    `json:"category_type"`
+	OperationDetail string    `json:"operation_detail"`
+	Operator        string    `json:"operator"`
+	Time            time.Time `json:"time"`
+}
+
+func (r *ReportsService) OperationLogs(ctx context.Context, opts *ReportsOperationLogsOptions) (*ReportsOperationLogsResponse, *http.Response, error) {
+	out := &ReportsOperationLogsResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/report/operationlogs", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *ReportsService) OperationLogsRange(ctx context.Context, from, to time.Time, opts *ReportsOperationLogsOptions) ([]*ReportsOperationLog, error) {
+	rangeOpts := &ReportsOperationLogsOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	logs, err := listAllInRange(ctx, from, to, reportsMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*ReportsOperationLog, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := r.OperationLogs(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.OperationLogs, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reporting operation logs: %w", err)
+	}
+
+	return logs, nil
+}
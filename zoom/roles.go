@@ -0,0 +1,228 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+)
+
+type RolesServicer interface {
+	List(ctx context.Context, opts *RolesListOptions) (*RolesListResponse, *http.Response, error)
+	Get(ctx context.Context, roleID string) (*Role, *http.Response, error)
+	Create(ctx context.Context, opts *RolesCreateOptions) (*Role, *http.Response, error)
+	Update(ctx context.Context, roleID string, opts *RolesUpdateOptions) (*http.Response, error)
+	Delete(ctx context.Context, roleID string) (*http.Response, error)
+
+	AddPrivileges(ctx context.Context, roleID string, privileges ...string) (*http.Response, error)
+	RemovePrivileges(ctx context.Context, roleID string, privileges ...string) (*http.Response, error)
+
+	ListMembers(ctx context.Context, roleID string, opts *PaginationOptions) (*RolesListMembersResponse, *http.Response, error)
+	AssignMembers(ctx context.Context, roleID string, opts *RolesAssignMembersOptions) (*RolesAssignMembersResponse, *http.Response, error)
+	UnassignMember(ctx context.Context, roleID string, memberID string) (*http.Response, error)
+}
+
+type RolesService struct {
+	client *Client
+}
+
+var _ RolesServicer = (*RolesService)(nil)
+
+func rolePath(roleID string) string {
+	return "/roles/" + url.QueryEscape(roleID)
+}
+
+type RolesListOptions struct {
+	Type *string `url:"type,omitempty"`
+}
+
+type RolesListResponse struct {
+	Roles        []*Role `json:"roles"`
+	TotalRecords int     `json:"total_records"`
+}
+
+type Role struct {
+	Description          string                    `json:"description"`
+	ID                   string                    `json:"id"`
+	Name                 string                    `json:"name"`
+	Privileges           []string                  `json:"privileges"`
+	SubAccountPrivileges *RoleSubAccountPrivileges `json:"sub_account_privileges"`
+	TotalMembers         int                       `json:"total_members"`
+	Type                 string                    `json:"type"`
+}
+
+type RoleSubAccountPrivileges struct {
+	SecondLevel int `json:"second_level"`
+}
+
+func (r *RolesService) List(ctx context.Context, opts *RolesListOptions) (*RolesListResponse, *http.Response, error) {
+	out := &RolesListResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/roles", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *RolesService) Get(ctx context.Context, roleID string) (*Role, *http.Response, error) {
+	out := &Role{}
+
+	res, err := r.client.request(ctx, http.MethodGet, rolePath(roleID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RolesCreateOptions struct {
+	Description *string  `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Privileges  []string `json:"privileges,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+}
+
+func (r *RolesService) Create(ctx context.Context, opts *RolesCreateOptions) (*Role, *http.Response, error) {
+	out := &Role{}
+
+	res, err := r.client.request(ctx, http.MethodPost, "/roles", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RolesUpdateOptions struct {
+	Description          *string                   `json:"description,omitempty"`
+	Name                 *string                   `json:"name,omitempty"`
+	Privileges           []string                  `json:"privileges,omitempty"`
+	SubAccountPrivileges *RoleSubAccountPrivileges `json:"sub_account_privileges,omitempty"`
+}
+
+// Update changes a role. Privileges, when set, replace the role's privileges entirely.
+func (r *RolesService) Update(ctx context.Context, roleID string, opts *RolesUpdateOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, rolePath(roleID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *RolesService) Delete(ctx context.Context, roleID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodDelete, rolePath(roleID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// AddPrivileges grants privileges to a role, keeping the privileges it already has.
+func (r *RolesService) AddPrivileges(ctx context.Context, roleID string, privileges ...string) (*http.Response, error) {
+	role, res, err := r.Get(ctx, roleID)
+	if err != nil {
+		return res, fmt.Errorf("getting role: %w", err)
+	}
+
+	updated := slices.Clone(role.Privileges)
+	for _, privilege := range privileges {
+		if !slices.Contains(updated, privilege) {
+			updated = append(updated, privilege)
+		}
+	}
+
+	return r.updatePrivileges(ctx, roleID, updated)
+}
+
+// RemovePrivileges revokes privileges from a role, keeping its other privileges.
+func (r *RolesService) RemovePrivileges(ctx context.Context, roleID string, privileges ...string) (*http.Response, error) {
+	role, res, err := r.Get(ctx, roleID)
+	if err != nil {
+		return res, fmt.Errorf("getting role: %w", err)
+	}
+
+	updated := slices.DeleteFunc(slices.Clone(role.Privileges), func(privilege string) bool {
+		return slices.Contains(privileges, privilege)
+	})
+
+	return r.updatePrivileges(ctx, roleID, updated)
+}
+
+// rolesPrivilegesBody always sends the privileges, so removing a role's last privilege leaves it with none.
+type rolesPrivilegesBody struct {
+	Privileges []string `json:"privileges"`
+}
+
+func (r *RolesService) updatePrivileges(ctx context.Context, roleID string, privileges []string) (*http.Response, error) {
+	if privileges == nil {
+		privileges = []string{}
+	}
+
+	res, err := r.client.request(ctx, http.MethodPatch, rolePath(roleID), nil, &rolesPrivilegesBody{Privileges: privileges}, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type RolesListMembersResponse struct {
+	*PaginationResponse
+
+	Members []*RolesMember `json:"members"`
+}
+
+type RolesMember struct {
+	Department string `json:"department"`
+	Email      string `json:"email"`
+	FirstName  string `json:"first_name"`
+	ID         string `json:"id"`
+	LastName   string `json:"last_name"`
+	Type       int    `json:"type"`
+}
+
+func (r *RolesService) ListMembers(ctx context.Context, roleID string, opts *PaginationOptions) (*RolesListMembersResponse, *http.Response, error) {
+	out := &RolesListMembersResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, rolePath(roleID)+"/members", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RolesAssignMembersOptions struct {
+	Members []*UserIdentifier `json:"members"`
+}
+
+type RolesAssignMembersResponse struct {
+	AddAt time.Time `json:"add_at"`
+	IDs   string    `json:"ids"`
+}
+
+func (r *RolesService) AssignMembers(ctx context.Context, roleID string, opts *RolesAssignMembersOptions) (*RolesAssignMembersResponse, *http.Response, error) {
+	out := &RolesAssignMembersResponse{}
+
+	res, err := r.client.request(ctx, http.MethodPost, rolePath(roleID)+"/members", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (r *RolesService) UnassignMember(ctx context.Context, roleID string, memberID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodDelete, rolePath(roleID)+"/members/"+url.QueryEscape(memberID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package zoom
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rewriteTransport sends requests to the test server instead of the Zoom API.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (r *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRolesService_RemovePrivileges(t *testing.T) {
+	assert := assert.New(t)
+
+	var body string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/v2/roles/r1", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"id":"r1","privileges":["User:Read","User:Edit"]}`))
+		case http.MethodPatch:
+			b, err := io.ReadAll(r.Body)
+			assert.NoError(err)
+			body = string(b)
+
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer s.Close()
+
+	target, err := url.Parse(s.URL)
+	assert.NoError(err)
+
+	client := newDownloadTestClient(t)
+	client.httpClient = &http.Client{Transport: &rewriteTransport{target: target}}
+
+	_, err = client.Roles.RemovePrivileges(context.Background(), "r1", "User:Edit")
+	assert.NoError(err)
+	assert.JSONEq(`{"privileges":["User:Read"]}`, body)
+
+	_, err = client.Roles.RemovePrivileges(context.Background(), "r1", "User:Read", "User:Edit")
+	assert.NoError(err)
+	assert.JSONEq(`{"privileges":[]}`, body)
+}
@@ -0,0 +1,528 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	RoomsTypeZoomRoom              RoomsType = "ZoomRoom"
+	RoomsTypeSchedulingDisplayOnly RoomsType = "SchedulingDisplayOnly"
+	RoomsTypeDigitalSignageOnly    RoomsType = "DigitalSignageOnly"
+
+	RoomsStatusOffline           RoomsStatus = "Offline"
+	RoomsStatusAvailable         RoomsStatus = "Available"
+	RoomsStatusInMeeting         RoomsStatus = "InMeeting"
+	RoomsStatusUnderConstruction RoomsStatus = "UnderConstruction"
+
+	RoomsSettingTypeMeeting RoomsSettingType = "meeting"
+	RoomsSettingTypeAlert   RoomsSettingType = "alert"
+	RoomsSettingTypeSignage RoomsSettingType = "signage"
+
+	RoomsDeviceStatusOnline  RoomsDeviceStatus = "Online"
+	RoomsDeviceStatusOffline RoomsDeviceStatus = "Offline"
+
+	RoomsEventCheckIn  RoomsEvent = "zoomroom.check_in"
+	RoomsEventCheckOut RoomsEvent = "zoomroom.check_out"
+
+	RoomsEventMeetingJoin   RoomsEvent = "zoomroom.meeting_join"
+	RoomsEventMeetingLeave  RoomsEvent = "zoomroom.meeting_leave"
+	RoomsEventMeetingInvite RoomsEvent = "zoomroom.meeting_invite"
+	RoomsEventMeetingCancel RoomsEvent = "zoomroom.meeting_cancel"
+	RoomsEventRestart       RoomsEvent = "zoomroom.restart"
+)
+
+type RoomsType string
+
+func (r RoomsType) String() string {
+	return string(r)
+}
+
+type RoomsStatus string
+
+func (r RoomsStatus) String() string {
+	return string(r)
+}
+
+// RoomsSettingType selects which settings of a room or location are read or updated.
+type RoomsSettingType string
+
+func (r RoomsSettingType) String() string {
+	return string(r)
+}
+
+type RoomsDeviceStatus string
+
+func (r RoomsDeviceStatus) String() string {
+	return string(r)
+}
+
+// RoomsEvent is an action taken by a room, such as checking in to a calendar event or joining a meeting.
+type RoomsEvent string
+
+func (r RoomsEvent) String() string {
+	return string(r)
+}
+
+type RoomsServicer interface {
+	List(ctx context.Context, opts *RoomsListOptions) (*RoomsListResponse, *http.Response, error)
+	ListAll(ctx context.Context, opts *RoomsListOptions) ([]*RoomsListItem, error)
+	Get(ctx context.Context, roomID string) (*RoomsGetResponse, *http.Response, error)
+	Create(ctx context.Context, opts *RoomsCreateOptions) (*RoomsListItem, *http.Response, error)
+	Update(ctx context.Context, roomID string, opts *RoomsUpdateOptions) (*http.Response, error)
+	Delete(ctx context.Context, roomID string) (*http.Response, error)
+
+	GetSettings(ctx context.Context, roomID string, opts *RoomsSettingsOptions) (RoomsSettings, *http.Response, error)
+	UpdateSettings(ctx context.Context, roomID string, settings RoomsSettings, opts *RoomsSettingsOptions) (*http.Response, error)
+
+	ListLocations(ctx context.Context, opts *RoomsListLocationsOptions) (*RoomsListLocationsResponse, *http.Response, error)
+	ListLocationsAll(ctx context.Context, opts *RoomsListLocationsOptions) ([]*RoomsLocation, error)
+	GetLocation(ctx context.Context, locationID string) (*RoomsGetLocationResponse, *http.Response, error)
+	CreateLocation(ctx context.Context, opts *RoomsCreateLocationOptions) (*RoomsLocation, *http.Response, error)
+	UpdateLocation(ctx context.Context, locationID string, opts *RoomsUpdateLocationOptions) (*http.Response, error)
+	MoveLocation(ctx context.Context, locationID string, parentLocationID string) (*http.Response, error)
+	DeleteLocation(ctx context.Context, locationID string) (*http.Response, error)
+	GetLocationStructure(ctx context.Context) (*RoomsLocationStructure, *http.Response, error)
+
+	ListDevices(ctx context.Context, roomID string) (*RoomsListDevicesResponse, *http.Response, error)
+
+	CheckIn(ctx context.Context, roomID string, opts *RoomsCheckInOptions) (*http.Response, error)
+	CheckOut(ctx context.Context, roomID string, opts *RoomsCheckInOptions) (*http.Response, error)
+	Control(ctx context.Context, roomID string, opts *RoomsControlOptions) (*http.Response, error)
+}
+
+// RoomsService covers Zoom Rooms, their locations and their devices.
+type RoomsService struct {
+	client *Client
+}
+
+var _ RoomsServicer = (*RoomsService)(nil)
+
+func roomsPath(roomID string) string {
+	return "/rooms/" + url.QueryEscape(roomID)
+}
+
+func roomsLocationPath(locationID string) string {
+	return "/rooms/locations/" + url.QueryEscape(locationID)
+}
+
+type RoomsListOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	LocationID      *string      `url:"location_id,omitempty"`
+	QueryName       *string      `url:"query_name,omitempty"`
+	Status          *RoomsStatus `url:"status,omitempty"`
+	Type            *RoomsType   `url:"type,omitempty"`
+	UnassignedRooms *bool        `url:"unassigned_rooms,omitempty"`
+}
+
+type RoomsListResponse struct {
+	*PaginationResponse
+
+	Rooms []*RoomsListItem `json:"rooms"`
+}
+
+type RoomsListItem struct {
+	ActivationCode string      `json:"activation_code"`
+	ID             string      `json:"id"`
+	LocationID     string      `json:"location_id"`
+	Name           string      `json:"name"`
+	RoomID         string      `json:"room_id"`
+	Status         RoomsStatus `json:"status"`
+	Type           RoomsType   `json:"type"`
+}
+
+func (r *RoomsService) List(ctx context.Context, opts *RoomsListOptions) (*RoomsListResponse, *http.Response, error) {
+	out := &RoomsListResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/rooms", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListAll follows pagination to list every room matching opts.
+func (r *RoomsService) ListAll(ctx context.Context, opts *RoomsListOptions) ([]*RoomsListItem, error) {
+	pageOpts := &RoomsListOptions{}
+	if opts != nil {
+		*pageOpts = *opts
+	}
+
+	var rooms []*RoomsListItem
+
+	err := listAll(ctx, pageOpts.PaginationOptions, func(page *PaginationOptions) (*PaginationResponse, error) {
+		pageOpts.PaginationOptions = page
+
+		out, _, err := r.List(ctx, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		rooms = append(rooms, out.Rooms...)
+
+		return out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing rooms: %w", err)
+	}
+
+	return rooms, nil
+}
+
+type RoomsGetResponse struct {
+	Basic  *RoomsBasic  `json:"basic"`
+	Device *RoomsDevice `json:"device"`
+	Setup  *RoomsSetup  `json:"setup"`
+}
+
+// RoomsBasic is a room's profile. It is used both to read and to update a room.
+type RoomsBasic struct {
+	ActivationCode      *string `json:"activation_code,omitempty"`
+	CalendarResourceID  *string `json:"calendar_resource_id,omitempty"`
+	DisplayName         *string `json:"display_name,omitempty"`
+	HideRoomInContacts  *bool   `json:"hide_room_in_contacts,omitempty"`
+	LocationID          *string `json:"location_id,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	ProvisioningPending *bool   `json:"provisioning_pending,omitempty"`
+	RequiredCodeToExt   *bool   `json:"required_code_to_ext,omitempty"`
+	RoomPasscode        *string `json:"room_passcode,omitempty"`
+	SupportEmail        *string `json:"support_email,omitempty"`
+	SupportPhone        *string `json:"support_phone,omitempty"`
+	ZoomRoomType        *string `json:"zoom_room_type,omitempty"`
+}
+
+type RoomsDevice struct {
+	DeviceProfileID *string `json:"device_profile_id,omitempty"`
+}
+
+type RoomsSetup struct {
+	ApplyBackgroundImageToAllDisplays *bool   `json:"apply_background_image_to_all_displays,omitempty"`
+	CheckinAndCheckout                *bool   `json:"checkin_and_checkout,omitempty"`
+	UnderConstruction                 *bool   `json:"under_construction,omitempty"`
+	ZoomRoomType                      *string `json:"zoom_room_type,omitempty"`
+}
+
+// Get returns a room's profile. roomID is the room's ID, not its RoomID.
+func (r *RoomsService) Get(ctx context.Context, roomID string) (*RoomsGetResponse, *http.Response, error) {
+	out := &RoomsGetResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, roomsPath(roomID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RoomsCreateOptions struct {
+	CalendarResourceID *string   `json:"calendar_resource_id,omitempty"`
+	LocationID         *string   `json:"location_id,omitempty"`
+	Name               string    `json:"name"`
+	Type               RoomsType `json:"type"`
+}
+
+func (r *RoomsService) Create(ctx context.Context, opts *RoomsCreateOptions) (*RoomsListItem, *http.Response, error) {
+	out := &RoomsListItem{}
+
+	res, err := r.client.request(ctx, http.MethodPost, "/rooms", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RoomsUpdateOptions struct {
+	Basic  *RoomsBasic  `json:"basic,omitempty"`
+	Device *RoomsDevice `json:"device,omitempty"`
+	Setup  *RoomsSetup  `json:"setup,omitempty"`
+}
+
+func (r *RoomsService) Update(ctx context.Context, roomID string, opts *RoomsUpdateOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, roomsPath(roomID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *RoomsService) Delete(ctx context.Context, roomID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodDelete, roomsPath(roomID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// RoomsSettings holds a room's or location's settings sections, keyed by their Zoom name such as zoom_rooms or
+// client_alert. Which sections there are depends on the RoomsSettingType.
+type RoomsSettings map[string]SettingsSection
+
+type RoomsSettingsOptions struct {
+	SettingType RoomsSettingType `url:"setting_type"`
+}
+
+func (r *RoomsService) GetSettings(ctx context.Context, roomID string, opts *RoomsSettingsOptions) (RoomsSettings, *http.Response, error) {
+	out := RoomsSettings{}
+
+	res, err := r.client.request(ctx, http.MethodGet, roomsPath(roomID)+"/settings", opts, nil, &out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// UpdateSettings changes only the sections and fields set in settings.
+func (r *RoomsService) UpdateSettings(ctx context.Context, roomID string, settings RoomsSettings, opts *RoomsSettingsOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, roomsPath(roomID)+"/settings", opts, settings, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type RoomsListLocationsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	ParentLocationID *string `url:"parent_location_id,omitempty"`
+	// Type is a level of the location structure, such as country, building or floor.
+	Type *string `url:"type,omitempty"`
+}
+
+type RoomsListLocationsResponse struct {
+	*PaginationResponse
+
+	Locations []*RoomsLocation `json:"locations"`
+}
+
+type RoomsLocation struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	ParentLocationID string `json:"parent_location_id"`
+	Type             string `json:"type"`
+}
+
+func (r *RoomsService) ListLocations(ctx context.Context, opts *RoomsListLocationsOptions) (*RoomsListLocationsResponse, *http.Response, error) {
+	out := &RoomsListLocationsResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/rooms/locations", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListLocationsAll follows pagination to list every location matching opts. Build the hierarchy from each location's
+// ParentLocationID.
+func (r *RoomsService) ListLocationsAll(ctx context.Context, opts *RoomsListLocationsOptions) ([]*RoomsLocation, error) {
+	pageOpts := &RoomsListLocationsOptions{}
+	if opts != nil {
+		*pageOpts = *opts
+	}
+
+	var locations []*RoomsLocation
+
+	err := listAll(ctx, pageOpts.PaginationOptions, func(page *PaginationOptions) (*PaginationResponse, error) {
+		pageOpts.PaginationOptions = page
+
+		out, _, err := r.ListLocations(ctx, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, out.Locations...)
+
+		return out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing locations: %w", err)
+	}
+
+	return locations, nil
+}
+
+type RoomsGetLocationResponse struct {
+	Basic *RoomsLocationBasic `json:"basic"`
+}
+
+// RoomsLocationBasic is a location's profile. It is used both to read and to update a location.
+type RoomsLocationBasic struct {
+	Address           *string `json:"address,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	RequiredCodeToExt *bool   `json:"required_code_to_ext,omitempty"`
+	RoomPasscode      *string `json:"room_passcode,omitempty"`
+	SupportEmail      *string `json:"support_email,omitempty"`
+	SupportPhone      *string `json:"support_phone,omitempty"`
+	Timezone          *string `json:"timezone,omitempty"`
+}
+
+func (r *RoomsService) GetLocation(ctx context.Context, locationID string) (*RoomsGetLocationResponse, *http.Response, error) {
+	out := &RoomsGetLocationResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, roomsLocationPath(locationID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RoomsCreateLocationOptions struct {
+	Name             string  `json:"name"`
+	ParentLocationID *string `json:"parent_location_id,omitempty"`
+}
+
+func (r *RoomsService) CreateLocation(ctx context.Context, opts *RoomsCreateLocationOptions) (*RoomsLocation, *http.Response, error) {
+	out := &RoomsLocation{}
+
+	res, err := r.client.request(ctx, http.MethodPost, "/rooms/locations", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RoomsUpdateLocationOptions struct {
+	Basic *RoomsLocationBasic `json:"basic,omitempty"`
+}
+
+func (r *RoomsService) UpdateLocation(ctx context.Context, locationID string, opts *RoomsUpdateLocationOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, roomsLocationPath(locationID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type roomsMoveLocationBody struct {
+	ParentLocationID string `json:"parent_location_id"`
+}
+
+// MoveLocation moves a location, with the locations and rooms under it, to another parent location.
+func (r *RoomsService) MoveLocation(ctx context.Context, locationID string, parentLocationID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPut, roomsLocationPath(locationID)+"/location", nil, &roomsMoveLocationBody{ParentLocationID: parentLocationID}, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *RoomsService) DeleteLocation(ctx context.Context, locationID string) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodDelete, roomsLocationPath(locationID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// RoomsLocationStructure is the account's location hierarchy, the type of each level from the top down.
+type RoomsLocationStructure struct {
+	Structure []string `json:"structure"`
+}
+
+func (r *RoomsService) GetLocationStructure(ctx context.Context) (*RoomsLocationStructure, *http.Response, error) {
+	out := &RoomsLocationStructure{}
+
+	res, err := r.client.request(ctx, http.MethodGet, "/rooms/locations/structure", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type RoomsListDevicesResponse struct {
+	Devices []*RoomsDeviceItem `json:"devices"`
+}
+
+type RoomsDeviceItem struct {
+	AppTargetVersion   string            `json:"app_target_version"`
+	AppVersion         string            `json:"app_version"`
+	DeviceMacAddresses []string          `json:"device_mac_addresses"`
+	DeviceSystem       string            `json:"device_system"`
+	DeviceType         string            `json:"device_type"`
+	ID                 string            `json:"id"`
+	IPAddress          string            `json:"ip_address"`
+	RoomName           string            `json:"room_name"`
+	Status             RoomsDeviceStatus `json:"status"`
+}
+
+// ListDevices lists a room's devices, such as its computer, controller and scheduling display, and whether they are
+// online.
+func (r *RoomsService) ListDevices(ctx context.Context, roomID string) (*RoomsListDevicesResponse, *http.Response, error) {
+	out := &RoomsListDevicesResponse{}
+
+	res, err := r.client.request(ctx, http.MethodGet, roomsPath(roomID)+"/devices", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// RoomsCheckInOptions identify the calendar event a room checks in to or out of.
+type RoomsCheckInOptions struct {
+	CalendarID    *string `json:"calendar_id,omitempty"`
+	ChangeKey     *string `json:"change_key,omitempty"`
+	EventID       string  `json:"event_id"`
+	ResourceEmail *string `json:"resource_email,omitempty"`
+}
+
+func (r *RoomsService) CheckIn(ctx context.Context, roomID string, opts *RoomsCheckInOptions) (*http.Response, error) {
+	return r.checkIn(ctx, roomID, RoomsEventCheckIn, opts)
+}
+
+func (r *RoomsService) CheckOut(ctx context.Context, roomID string, opts *RoomsCheckInOptions) (*http.Response, error) {
+	return r.checkIn(ctx, roomID, RoomsEventCheckOut, opts)
+}
+
+type RoomsControlOptions struct {
+	Method RoomsEvent          `json:"method"`
+	Params *RoomsControlParams `json:"params,omitempty"`
+}
+
+// RoomsControlParams are required to join a meeting, and to invite contacts to or cancel a scheduled meeting.
+type RoomsControlParams struct {
+	Callee        []string `json:"callee,omitempty"`
+	ForceAccept   *bool    `json:"force_accept,omitempty"`
+	MeetingNumber *string  `json:"meeting_number,omitempty"`
+	Passcode      *string  `json:"passcode,omitempty"`
+}
+
+// Control makes a room join or leave a meeting, invite contacts to it, cancel it or restart the Zoom Rooms app.
+func (r *RoomsService) Control(ctx context.Context, roomID string, opts *RoomsControlOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, roomsPath(roomID)+"/events", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type roomsCheckInBody struct {
+	Method RoomsEvent           `json:"method"`
+	Params *RoomsCheckInOptions `json:"params,omitempty"`
+}
+
+func (r *RoomsService) checkIn(ctx context.Context, roomID string, method RoomsEvent, opts *RoomsCheckInOptions) (*http.Response, error) {
+	res, err := r.client.request(ctx, http.MethodPatch, roomsPath(roomID)+"/events", nil, &roomsCheckInBody{Method: method, Params: opts}, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
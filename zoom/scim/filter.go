@@ -0,0 +1,36 @@
+package scim
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// filter is a SCIM filter of the form `attribute eq "value"`, the only form identity providers use to look up users
+// and groups before provisioning them.
+type filter struct {
+	attribute string
+	value     string
+}
+
+func parseFilter(s string) (*filter, error) {
+	attribute, rest, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", "filter must be of the form attribute eq \"value\"")
+	}
+
+	op, value, ok := strings.Cut(strings.TrimSpace(rest), " ")
+	if !ok || !strings.EqualFold(op, "eq") {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", "only the eq operator is supported")
+	}
+
+	unquoted, err := strconv.Unquote(strings.TrimSpace(value))
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", "filter value must be a quoted string")
+	}
+
+	return &filter{
+		attribute: strings.ToLower(attribute),
+		value:     unquoted,
+	}, nil
+}
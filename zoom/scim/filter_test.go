@@ -0,0 +1,27 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := parseFilter(`userName eq "jane@example.com"`)
+	assert.NoError(err)
+	assert.Equal(&filter{attribute: "username", value: "jane@example.com"}, f)
+
+	f, err = parseFilter(`displayName EQ "Sales Team"`)
+	assert.NoError(err)
+	assert.Equal(&filter{attribute: "displayname", value: "Sales Team"}, f)
+
+	for _, s := range []string{`userName`, `userName co "jane"`, `userName eq jane`} {
+		_, err = parseFilter(s)
+
+		var scimErr *Error
+		assert.ErrorAs(err, &scimErr, s)
+		assert.Equal("invalidFilter", scimErr.ScimType, s)
+	}
+}
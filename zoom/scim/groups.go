@@ -0,0 +1,421 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+func groupFromZoom(g *zoom.Group, members []*zoom.GroupsMember) *Group {
+	group := &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          g.ID,
+		DisplayName: g.Name,
+		Meta: &Meta{
+			ResourceType: "Group",
+		},
+	}
+
+	for _, member := range members {
+		display := strings.TrimSpace(member.FirstName + " " + member.LastName)
+		if len(display) == 0 {
+			display = member.Email
+		}
+
+		group.Members = append(group.Members, &Member{
+			Value:   member.ID,
+			Display: display,
+		})
+	}
+
+	return group
+}
+
+func (h *Handler) zoomGroups(ctx context.Context) ([]*zoom.Group, error) {
+	var groups []*zoom.Group
+
+	opts := &zoom.PaginationOptions{
+		PageSize: zoom.Ptr(300),
+	}
+
+	for {
+		out, res, err := h.groups.List(ctx, opts)
+		if err != nil {
+			return nil, zoomError(err, res)
+		}
+
+		groups = append(groups, out.Groups...)
+
+		if out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+			return groups, nil
+		}
+
+		opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+	}
+}
+
+// group returns a group along with all of its members.
+func (h *Handler) group(ctx context.Context, id string) (*Group, error) {
+	g, res, err := h.groups.Get(ctx, id)
+	if err != nil {
+		return nil, zoomError(err, res)
+	}
+
+	var members []*zoom.GroupsMember
+
+	opts := &zoom.PaginationOptions{
+		PageSize: zoom.Ptr(300),
+	}
+
+	for {
+		out, res, err := h.groups.ListMembers(ctx, id, opts)
+		if err != nil {
+			return nil, zoomError(err, res)
+		}
+
+		members = append(members, out.Members...)
+
+		if out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+			break
+		}
+
+		opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+	}
+
+	return groupFromZoom(g, members), nil
+}
+
+// listGroups lists groups without their members, which would take a request per group. Get a group to read its
+// members.
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	var f *filter
+	if query := r.URL.Query().Get("filter"); len(query) > 0 {
+		var err error
+		f, err = parseFilter(query)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch f.attribute {
+		case "displayname", "id":
+		default:
+			writeError(w, newError(http.StatusBadRequest, "invalidFilter", "groups can only be filtered by id or displayName"))
+			return
+		}
+	}
+
+	zoomGroups, err := h.zoomGroups(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var groups []*Group
+	for _, g := range zoomGroups {
+		if f != nil && (f.attribute == "id" && g.ID != f.value || f.attribute == "displayname" && !strings.EqualFold(g.Name, f.value)) {
+			continue
+		}
+
+		groups = append(groups, groupFromZoom(g, nil))
+	}
+
+	writeJSON(w, http.StatusOK, listResponse(r, groups))
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	group, err := h.group(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	group := &Group{}
+	err := decode(r, group)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if len(group.DisplayName) == 0 {
+		writeError(w, newError(http.StatusBadRequest, "invalidValue", "displayName is required"))
+		return
+	}
+
+	created, res, err := h.groups.Create(r.Context(), &zoom.GroupsCreateOptions{
+		Name: group.DisplayName,
+	})
+	if err != nil {
+		writeError(w, zoomError(err, res))
+		return
+	}
+
+	err = h.addMembers(r.Context(), created.ID, memberIDs(group.Members))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	group, err = h.group(r.Context(), created.ID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	current, err := h.group(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	group := &Group{}
+	err = decode(r, group)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	h.updateGroup(w, r, current, group)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	current, err := h.group(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	patch := &PatchRequest{}
+	err = decode(r, patch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	group := &Group{
+		DisplayName: current.DisplayName,
+		Members:     slices.Clone(current.Members),
+	}
+
+	for _, op := range patch.Operations {
+		err = patchGroup(group, op)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	h.updateGroup(w, r, current, group)
+}
+
+// updateGroup renames the Zoom group and adds and removes members to match the desired group, then writes back the
+// updated group.
+func (h *Handler) updateGroup(w http.ResponseWriter, r *http.Request, current *Group, group *Group) {
+	if len(group.DisplayName) > 0 && group.DisplayName != current.DisplayName {
+		res, err := h.groups.Update(r.Context(), current.ID, &zoom.GroupsUpdateOptions{
+			Name: zoom.Ptr(group.DisplayName),
+		})
+		if err != nil {
+			writeError(w, zoomError(err, res))
+			return
+		}
+	}
+
+	desired := memberIDs(group.Members)
+	existing := memberIDs(current.Members)
+
+	var add []string
+	for _, id := range desired {
+		if !slices.Contains(existing, id) {
+			add = append(add, id)
+		}
+	}
+
+	err := h.addMembers(r.Context(), current.ID, add)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	for _, id := range existing {
+		if slices.Contains(desired, id) {
+			continue
+		}
+
+		res, err := h.groups.RemoveMember(r.Context(), current.ID, id)
+		if err != nil {
+			writeError(w, zoomError(err, res))
+			return
+		}
+	}
+
+	updated, err := h.group(r.Context(), current.ID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (h *Handler) addMembers(ctx context.Context, groupID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	members := make([]*zoom.UserIdentifier, len(ids))
+	for i, id := range ids {
+		members[i] = &zoom.UserIdentifier{ID: zoom.Ptr(id)}
+	}
+
+	_, err := h.groups.AddMembersBatched(ctx, groupID, members)
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", err.Error())
+	}
+
+	return nil
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	res, err := h.groups.Delete(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, zoomError(err, res))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func memberIDs(members []*Member) []string {
+	var ids []string
+	for _, member := range members {
+		if !slices.Contains(ids, member.Value) {
+			ids = append(ids, member.Value)
+		}
+	}
+
+	return ids
+}
+
+// patchGroup applies a single patch operation to group. Members can be added, replaced or removed, either all at once
+// or by filtering on their value such as `members[value eq "id"]`.
+func patchGroup(group *Group, op *PatchOperation) error {
+	kind := strings.ToLower(op.Op)
+	switch kind {
+	case "add", "replace", "remove":
+	default:
+		return newError(http.StatusBadRequest, "invalidSyntax", "unsupported patch op "+strconv.Quote(op.Op))
+	}
+
+	if len(op.Path) == 0 {
+		values, ok := op.Value.(map[string]any)
+		if kind == "remove" || !ok {
+			return newError(http.StatusBadRequest, "invalidValue", "patch value without a path must be an object")
+		}
+
+		for attribute, value := range values {
+			err := patchGroupAttribute(group, kind, attribute, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return patchGroupAttribute(group, kind, op.Path, op.Value)
+}
+
+func patchGroupAttribute(group *Group, kind string, path string, value any) error {
+	attribute, selector, _ := strings.Cut(path, "[")
+
+	switch strings.ToLower(attribute) {
+	case "displayname":
+		name, err := stringValue("displayName", value)
+		if err != nil || kind == "remove" {
+			return newError(http.StatusBadRequest, "invalidValue", "displayName must be a string")
+		}
+
+		group.DisplayName = name
+
+		return nil
+	case "members":
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path "+strconv.Quote(path))
+	}
+
+	var members []*Member
+	if len(selector) > 0 {
+		f, err := parseFilter(strings.TrimSuffix(selector, "]"))
+		if err != nil {
+			return err
+		}
+
+		if f.attribute != "value" || kind != "remove" {
+			return newError(http.StatusBadRequest, "invalidPath", "members can only be removed by value")
+		}
+
+		members = []*Member{{Value: f.value}}
+	} else if value != nil {
+		var err error
+		members, err = membersValue(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch kind {
+	case "add":
+		group.Members = append(group.Members, members...)
+	case "replace":
+		group.Members = members
+	case "remove":
+		if len(members) == 0 {
+			group.Members = nil
+			break
+		}
+
+		remove := memberIDs(members)
+		group.Members = slices.DeleteFunc(group.Members, func(member *Member) bool {
+			return slices.Contains(remove, member.Value)
+		})
+	}
+
+	return nil
+}
+
+func membersValue(value any) ([]*Member, error) {
+	values, ok := value.([]any)
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "members must be an array")
+	}
+
+	var members []*Member
+	for _, v := range values {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "member must be an object")
+		}
+
+		id, ok := m["value"].(string)
+		if !ok || len(id) == 0 {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "member value must be a string")
+		}
+
+		members = append(members, &Member{Value: id})
+	}
+
+	return members, nil
+}
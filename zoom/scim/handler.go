@@ -0,0 +1,153 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const (
+	defaultCount = 100
+	maxCount     = 1000
+)
+
+// Handler serves the SCIM 2.0 /Users and /Groups endpoints, translating them into Zoom user and group calls. Mount it
+// with http.StripPrefix when it is not served at the root.
+type Handler struct {
+	users  zoom.UsersServicer
+	groups zoom.GroupsServicer
+
+	mux *http.ServeMux
+
+	// CreateAction is the action used to create users, zoom.ActionCreate by default.
+	CreateAction zoom.UsersCreateAction
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+func NewHandler(users zoom.UsersServicer, groups zoom.GroupsServicer) *Handler {
+	h := &Handler{
+		users:  users,
+		groups: groups,
+		mux:    http.NewServeMux(),
+
+		CreateAction: zoom.ActionCreate,
+	}
+
+	h.mux.HandleFunc("GET /Users", h.listUsers)
+	h.mux.HandleFunc("POST /Users", h.createUser)
+	h.mux.HandleFunc("GET /Users/{id}", h.getUser)
+	h.mux.HandleFunc("PUT /Users/{id}", h.replaceUser)
+	h.mux.HandleFunc("PATCH /Users/{id}", h.patchUser)
+	h.mux.HandleFunc("DELETE /Users/{id}", h.deleteUser)
+
+	h.mux.HandleFunc("GET /Groups", h.listGroups)
+	h.mux.HandleFunc("POST /Groups", h.createGroup)
+	h.mux.HandleFunc("GET /Groups/{id}", h.getGroup)
+	h.mux.HandleFunc("PUT /Groups/{id}", h.replaceGroup)
+	h.mux.HandleFunc("PATCH /Groups/{id}", h.patchGroup)
+	h.mux.HandleFunc("DELETE /Groups/{id}", h.deleteGroup)
+
+	h.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, newError(http.StatusNotFound, "", "resource not found"))
+	})
+
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func newError(status int, scimType string, detail string) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+		status:   status,
+	}
+}
+
+// zoomError converts an error returned by Zoom into a SCIM error, keeping Zoom's client error status codes.
+func zoomError(err error, res *http.Response) *Error {
+	status := http.StatusInternalServerError
+	if res != nil && res.StatusCode >= http.StatusBadRequest && res.StatusCode < http.StatusInternalServerError {
+		status = res.StatusCode
+	}
+
+	scimType := ""
+	switch status {
+	case http.StatusBadRequest:
+		scimType = "invalidValue"
+	case http.StatusConflict:
+		scimType = "uniqueness"
+	}
+
+	return newError(status, scimType, err.Error())
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var scimErr *Error
+	if !errors.As(err, &scimErr) {
+		scimErr = newError(http.StatusInternalServerError, "", err.Error())
+	}
+
+	writeJSON(w, scimErr.status, scimErr)
+}
+
+func decode(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "decoding request body: "+err.Error())
+	}
+
+	return nil
+}
+
+// page returns the 1-based start index and count requested, along with the matching slice bounds of n resources.
+func page(r *http.Request, n int) (int, int, int) {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 {
+		count = defaultCount
+	}
+	count = min(count, maxCount)
+
+	start := min(startIndex-1, n)
+	end := min(start+count, n)
+
+	return startIndex, start, end
+}
+
+func listResponse[T any](r *http.Request, resources []T) *ListResponse {
+	startIndex, start, end := page(r, len(resources))
+
+	out := &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: end - start,
+		Resources:    []any{},
+	}
+
+	for _, resource := range resources[start:end] {
+		out.Resources = append(out.Resources, resource)
+	}
+
+	return out
+}
@@ -0,0 +1,496 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/fterrag/go-zoom/zoom"
+	"github.com/stretchr/testify/assert"
+)
+
+var errNotFound = errors.New("not found")
+
+// fakeUsers and fakeGroups keep users and groups in memory.
+type fakeUsers struct {
+	zoom.UsersServicer
+
+	users []*zoom.UsersListItem
+}
+
+func notFound() *http.Response {
+	return &http.Response{StatusCode: http.StatusNotFound}
+}
+
+func (f *fakeUsers) find(userID string) *zoom.UsersListItem {
+	for _, user := range f.users {
+		if user.ID == userID || strings.EqualFold(user.Email, userID) {
+			return user
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeUsers) List(ctx context.Context, opts *zoom.UsersListOptions) (*zoom.UsersListResponse, *http.Response, error) {
+	out := &zoom.UsersListResponse{}
+	for _, user := range f.users {
+		if user.Status == *opts.Status {
+			out.Users = append(out.Users, user)
+		}
+	}
+
+	return out, nil, nil
+}
+
+func (f *fakeUsers) Get(ctx context.Context, userID string, opts *zoom.UsersGetOptions) (*zoom.UsersGetResponse, *http.Response, error) {
+	user := f.find(userID)
+	if user == nil {
+		return nil, notFound(), errNotFound
+	}
+
+	return &zoom.UsersGetResponse{UsersListItem: *user}, nil, nil
+}
+
+func (f *fakeUsers) Create(ctx context.Context, opts *zoom.UsersCreateOptions) (*zoom.UsersCreateResponse, *http.Response, error) {
+	if f.find(opts.UserInfo.Email) != nil {
+		return nil, &http.Response{StatusCode: http.StatusConflict}, errors.New("user already exists")
+	}
+
+	user := &zoom.UsersListItem{
+		ID:     "u" + opts.UserInfo.Email,
+		Email:  opts.UserInfo.Email,
+		Type:   opts.UserInfo.Type,
+		Status: "pending",
+	}
+
+	if opts.UserInfo.FirstName != nil {
+		user.FirstName = *opts.UserInfo.FirstName
+	}
+
+	if opts.UserInfo.LastName != nil {
+		user.LastName = *opts.UserInfo.LastName
+	}
+
+	f.users = append(f.users, user)
+
+	return &zoom.UsersCreateResponse{ID: user.ID}, nil, nil
+}
+
+func (f *fakeUsers) Update(ctx context.Context, userID string, opts *zoom.UsersUpdateOptions) (*http.Response, error) {
+	user := f.find(userID)
+	if user == nil {
+		return notFound(), errNotFound
+	}
+
+	if opts.FirstName != nil {
+		user.FirstName = *opts.FirstName
+	}
+
+	if opts.LastName != nil {
+		user.LastName = *opts.LastName
+	}
+
+	if opts.DisplayName != nil {
+		user.DisplayName = *opts.DisplayName
+	}
+
+	if opts.Dept != nil {
+		user.Dept = *opts.Dept
+	}
+
+	if opts.Type != nil {
+		user.Type = *opts.Type
+	}
+
+	return nil, nil
+}
+
+func (f *fakeUsers) UpdateStatus(ctx context.Context, userID string, opts *zoom.UsersUpdateStatusOptions) (*http.Response, error) {
+	user := f.find(userID)
+	if user == nil {
+		return notFound(), errNotFound
+	}
+
+	user.Status = "active"
+	if opts.Action == zoom.UsersStatusActionDeactivate {
+		user.Status = "inactive"
+	}
+
+	return nil, nil
+}
+
+func (f *fakeUsers) Delete(ctx context.Context, userID string, opts *zoom.UsersDeleteOptions) (*http.Response, error) {
+	user := f.find(userID)
+	if user == nil {
+		return notFound(), errNotFound
+	}
+
+	f.users = slices.DeleteFunc(f.users, func(u *zoom.UsersListItem) bool {
+		return u == user
+	})
+
+	return nil, nil
+}
+
+type fakeGroups struct {
+	zoom.GroupsServicer
+
+	groups  []*zoom.Group
+	members map[string][]string
+}
+
+func (f *fakeGroups) find(groupID string) *zoom.Group {
+	for _, group := range f.groups {
+		if group.ID == groupID {
+			return group
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeGroups) List(ctx context.Context, opts *zoom.PaginationOptions) (*zoom.GroupsListResponse, *http.Response, error) {
+	return &zoom.GroupsListResponse{Groups: f.groups}, nil, nil
+}
+
+func (f *fakeGroups) Get(ctx context.Context, groupID string) (*zoom.Group, *http.Response, error) {
+	group := f.find(groupID)
+	if group == nil {
+		return nil, notFound(), errNotFound
+	}
+
+	return group, nil, nil
+}
+
+func (f *fakeGroups) Create(ctx context.Context, opts *zoom.GroupsCreateOptions) (*zoom.Group, *http.Response, error) {
+	group := &zoom.Group{ID: "g" + opts.Name, Name: opts.Name}
+	f.groups = append(f.groups, group)
+
+	return group, nil, nil
+}
+
+func (f *fakeGroups) Update(ctx context.Context, groupID string, opts *zoom.GroupsUpdateOptions) (*http.Response, error) {
+	f.find(groupID).Name = *opts.Name
+
+	return nil, nil
+}
+
+func (f *fakeGroups) Delete(ctx context.Context, groupID string) (*http.Response, error) {
+	if f.find(groupID) == nil {
+		return notFound(), errNotFound
+	}
+
+	f.groups = slices.DeleteFunc(f.groups, func(g *zoom.Group) bool {
+		return g.ID == groupID
+	})
+
+	return nil, nil
+}
+
+func (f *fakeGroups) ListMembers(ctx context.Context, groupID string, opts *zoom.PaginationOptions) (*zoom.GroupsListMembersResponse, *http.Response, error) {
+	out := &zoom.GroupsListMembersResponse{}
+	for _, id := range f.members[groupID] {
+		out.Members = append(out.Members, &zoom.GroupsMember{ID: id, Email: id + "@example.com"})
+	}
+
+	return out, nil, nil
+}
+
+func (f *fakeGroups) AddMembersBatched(ctx context.Context, groupID string, members []*zoom.UserIdentifier) ([]string, error) {
+	for _, member := range members {
+		f.members[groupID] = append(f.members[groupID], *member.ID)
+	}
+
+	return nil, nil
+}
+
+func (f *fakeGroups) RemoveMember(ctx context.Context, groupID string, memberID string) (*http.Response, error) {
+	f.members[groupID] = slices.DeleteFunc(f.members[groupID], func(id string) bool {
+		return id == memberID
+	})
+
+	return nil, nil
+}
+
+func newTestHandler() (*Handler, *fakeUsers, *fakeGroups) {
+	users := &fakeUsers{
+		users: []*zoom.UsersListItem{
+			{ID: "u1", Email: "jane@example.com", FirstName: "Jane", LastName: "Doe", DisplayName: "Jane Doe", Type: 2, Dept: "Sales", Status: "active"},
+			{ID: "u2", Email: "john@example.com", FirstName: "John", Type: 1, Status: "inactive"},
+		},
+	}
+
+	groups := &fakeGroups{
+		groups: []*zoom.Group{
+			{ID: "g1", Name: "Sales"},
+			{ID: "g2", Name: "Support"},
+		},
+		members: map[string][]string{
+			"g1": {"u1"},
+		},
+	}
+
+	return NewHandler(users, groups), users, groups
+}
+
+func serve(h http.Handler, method string, target string, body string, out any) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	if out != nil {
+		_ = json.Unmarshal(rec.Body.Bytes(), out)
+	}
+
+	return rec
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	assert := assert.New(t)
+
+	h, _, _ := newTestHandler()
+
+	out := &ListResponse{}
+	rec := serve(h, http.MethodGet, "/Users?startIndex=2&count=5", "", out)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal(contentType, rec.Header().Get("Content-Type"))
+	assert.Equal(2, out.TotalResults)
+	assert.Equal(2, out.StartIndex)
+	assert.Equal(1, out.ItemsPerPage)
+	assert.Equal("john@example.com", out.Resources[0].(map[string]any)["userName"])
+	assert.Equal(false, out.Resources[0].(map[string]any)["active"])
+}
+
+func TestHandler_ListUsers_Filter(t *testing.T) {
+	assert := assert.New(t)
+
+	h, _, _ := newTestHandler()
+
+	out := &ListResponse{}
+	serve(h, http.MethodGet, `/Users?filter=userName+eq+"JANE@example.com"`, "", out)
+	assert.Equal(1, out.TotalResults)
+
+	out = &ListResponse{}
+	serve(h, http.MethodGet, `/Users?filter=userName+eq+"nobody@example.com"`, "", out)
+	assert.Equal(0, out.TotalResults)
+	assert.Equal([]any{}, out.Resources)
+
+	// Zoom finds users by email when given an email as the ID, which must not match an id filter.
+	out = &ListResponse{}
+	serve(h, http.MethodGet, `/Users?filter=id+eq+"jane@example.com"`, "", out)
+	assert.Equal(0, out.TotalResults)
+
+	scimErr := &Error{}
+	rec := serve(h, http.MethodGet, `/Users?filter=title+eq+"CEO"`, "", scimErr)
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.Equal("invalidFilter", scimErr.ScimType)
+}
+
+func TestHandler_GetUser(t *testing.T) {
+	assert := assert.New(t)
+
+	h, _, _ := newTestHandler()
+
+	user := &User{}
+	rec := serve(h, http.MethodGet, "/Users/u1", "", user)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("jane@example.com", user.UserName)
+	assert.Equal(&Name{GivenName: "Jane", FamilyName: "Doe"}, user.Name)
+	assert.Equal(UserTypeLicensed, user.UserType)
+	assert.Equal("Sales", user.Enterprise.Department)
+	assert.True(*user.Active)
+
+	scimErr := &Error{}
+	rec = serve(h, http.MethodGet, "/Users/missing", "", scimErr)
+
+	assert.Equal(http.StatusNotFound, rec.Code)
+	assert.Equal([]string{SchemaError}, scimErr.Schemas)
+	assert.Equal("404", scimErr.Status)
+}
+
+func TestHandler_CreateUser(t *testing.T) {
+	assert := assert.New(t)
+
+	h, users, _ := newTestHandler()
+
+	user := &User{}
+	rec := serve(h, http.MethodPost, "/Users", `{
+		"schemas": ["`+SchemaUser+`"],
+		"userName": "new@example.com",
+		"name": {"givenName": "New", "familyName": "User"},
+		"userType": "Licensed",
+		"`+SchemaEnterpriseUser+`": {"department": "Support"}
+	}`, user)
+
+	assert.Equal(http.StatusCreated, rec.Code)
+	assert.Equal("unew@example.com", user.ID)
+	assert.Equal("Support", users.find("new@example.com").Dept)
+	assert.Equal(2, users.find("new@example.com").Type)
+
+	scimErr := &Error{}
+	rec = serve(h, http.MethodPost, "/Users", `{"userName": "jane@example.com"}`, scimErr)
+
+	assert.Equal(http.StatusConflict, rec.Code)
+	assert.Equal("uniqueness", scimErr.ScimType)
+
+	rec = serve(h, http.MethodPost, "/Users", `{"name": {"givenName": "Nobody"}}`, scimErr)
+	assert.Equal(http.StatusBadRequest, rec.Code)
+
+	rec = serve(h, http.MethodPost, "/Users", `{`, scimErr)
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.Equal("invalidSyntax", scimErr.ScimType)
+}
+
+func TestHandler_ReplaceUser(t *testing.T) {
+	assert := assert.New(t)
+
+	h, users, _ := newTestHandler()
+
+	user := &User{}
+	rec := serve(h, http.MethodPut, "/Users/u1", `{
+		"userName": "jane@example.com",
+		"name": {"givenName": "Janet", "familyName": "Doe"},
+		"active": false
+	}`, user)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("Janet", user.Name.GivenName)
+	assert.False(*user.Active)
+	assert.Equal("inactive", users.find("u1").Status)
+	assert.Empty(users.find("u1").DisplayName)
+	assert.Empty(users.find("u1").Dept)
+
+	rec = serve(h, http.MethodPut, "/Users/u1", `{"userName": "jane@example.com"}`, nil)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Empty(users.find("u1").FirstName)
+	assert.Empty(users.find("u1").LastName)
+	assert.Equal("inactive", users.find("u1").Status)
+
+	scimErr := &Error{}
+	rec = serve(h, http.MethodPut, "/Users/u1", `{"userName": "other@example.com"}`, scimErr)
+
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.Equal("mutability", scimErr.ScimType)
+}
+
+func TestHandler_PatchUser(t *testing.T) {
+	assert := assert.New(t)
+
+	h, users, _ := newTestHandler()
+
+	rec := serve(h, http.MethodPatch, "/Users/u1", `{
+		"schemas": ["`+SchemaPatchOp+`"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "replace", "path": "`+SchemaEnterpriseUser+`:department", "value": "Marketing"},
+			{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jane@example.com"},
+			{"op": "replace", "value": {"name": {"familyName": "Smith"}}}
+		]
+	}`, nil)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("inactive", users.find("u1").Status)
+	assert.Equal("Marketing", users.find("u1").Dept)
+	assert.Equal("Smith", users.find("u1").LastName)
+	assert.Equal("Jane", users.find("u1").FirstName)
+	assert.Equal("Jane Doe", users.find("u1").DisplayName)
+
+	rec = serve(h, http.MethodPatch, "/Users/u2", `{"Operations": [{"op": "replace", "value": {"active": true}}]}`, nil)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("active", users.find("u2").Status)
+
+	scimErr := &Error{}
+	rec = serve(h, http.MethodPatch, "/Users/u1", `{"Operations": [{"op": "replace", "path": "title", "value": "CEO"}]}`, scimErr)
+
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.Equal("invalidPath", scimErr.ScimType)
+}
+
+func TestHandler_DeleteUser(t *testing.T) {
+	assert := assert.New(t)
+
+	h, users, _ := newTestHandler()
+
+	rec := serve(h, http.MethodDelete, "/Users/u1", "", nil)
+
+	assert.Equal(http.StatusNoContent, rec.Code)
+	assert.Nil(users.find("u1"))
+
+	rec = serve(h, http.MethodDelete, "/Users/u1", "", nil)
+	assert.Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_Groups(t *testing.T) {
+	assert := assert.New(t)
+
+	h, _, groups := newTestHandler()
+
+	out := &ListResponse{}
+	serve(h, http.MethodGet, `/Groups?filter=displayName+eq+"sales"`, "", out)
+	assert.Equal(1, out.TotalResults)
+
+	group := &Group{}
+	rec := serve(h, http.MethodGet, "/Groups/g1", "", group)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal([]*Member{{Value: "u1", Display: "u1@example.com"}}, group.Members)
+
+	rec = serve(h, http.MethodPost, "/Groups", `{"displayName": "Engineering", "members": [{"value": "u1"}, {"value": "u2"}]}`, group)
+	assert.Equal(http.StatusCreated, rec.Code)
+	assert.Equal("gEngineering", group.ID)
+	assert.Equal([]string{"u1", "u2"}, groups.members["gEngineering"])
+
+	rec = serve(h, http.MethodPut, "/Groups/g1", `{"displayName": "Sales EMEA", "members": [{"value": "u2"}]}`, group)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("Sales EMEA", groups.find("g1").Name)
+	assert.Equal([]string{"u2"}, groups.members["g1"])
+
+	rec = serve(h, http.MethodDelete, "/Groups/g2", "", nil)
+	assert.Equal(http.StatusNoContent, rec.Code)
+	assert.Nil(groups.find("g2"))
+}
+
+func TestHandler_PatchGroup(t *testing.T) {
+	assert := assert.New(t)
+
+	h, _, groups := newTestHandler()
+
+	rec := serve(h, http.MethodPatch, "/Groups/g1", `{"Operations": [
+		{"op": "add", "path": "members", "value": [{"value": "u2"}, {"value": "u3"}]},
+		{"op": "remove", "path": "members[value eq \"u1\"]"}
+	]}`, nil)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal([]string{"u2", "u3"}, groups.members["g1"])
+
+	rec = serve(h, http.MethodPatch, "/Groups/g1", `{"Operations": [
+		{"op": "replace", "value": {"displayName": "Renamed"}},
+		{"op": "remove", "path": "members"}
+	]}`, nil)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("Renamed", groups.find("g1").Name)
+	assert.Empty(groups.members["g1"])
+}
+
+func TestHandler_NotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	h, _, _ := newTestHandler()
+
+	scimErr := &Error{}
+	rec := serve(h, http.MethodGet, "/Schemas", "", scimErr)
+
+	assert.Equal(http.StatusNotFound, rec.Code)
+	assert.Equal("404", scimErr.Status)
+}
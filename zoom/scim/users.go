@@ -0,0 +1,511 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fterrag/go-zoom/zoom"
+)
+
+const statusInactive = "inactive"
+
+// listStatuses are the statuses listed for an unfiltered user list, as Zoom lists only active users by default.
+var listStatuses = []string{"active", "inactive", "pending"}
+
+func userFromZoom(u *zoom.UsersListItem) *User {
+	user := &User{
+		Schemas:  []string{SchemaUser, SchemaEnterpriseUser},
+		ID:       u.ID,
+		UserName: u.Email,
+		Name: &Name{
+			GivenName:  u.FirstName,
+			FamilyName: u.LastName,
+		},
+		DisplayName: u.DisplayName,
+		Emails: []*Email{
+			{Value: u.Email, Type: "work", Primary: true},
+		},
+		UserType: userType(u.Type),
+		Active:   zoom.Ptr(u.Status != statusInactive),
+		Meta: &Meta{
+			ResourceType: "User",
+		},
+	}
+
+	if len(u.Dept) > 0 {
+		user.Enterprise = &EnterpriseUser{Department: u.Dept}
+	}
+
+	for _, groupID := range u.GroupIDs {
+		user.Groups = append(user.Groups, &Member{Value: groupID})
+	}
+
+	if !u.UserCreatedAt.IsZero() {
+		user.Meta.Created = zoom.Ptr(u.UserCreatedAt)
+	}
+
+	return user
+}
+
+func userType(t int) string {
+	switch t {
+	case 1:
+		return UserTypeBasic
+	case 2:
+		return UserTypeLicensed
+	default:
+		return ""
+	}
+}
+
+func zoomUserType(userType string) (int, error) {
+	switch {
+	case strings.EqualFold(userType, UserTypeBasic):
+		return 1, nil
+	case strings.EqualFold(userType, UserTypeLicensed):
+		return 2, nil
+	default:
+		return 0, newError(http.StatusBadRequest, "invalidValue", "userType must be Basic or Licensed")
+	}
+}
+
+// email returns the user's userName, falling back to its primary email.
+func (u *User) email() string {
+	if len(u.UserName) > 0 {
+		return u.UserName
+	}
+
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+
+	return ""
+}
+
+func (h *Handler) zoomUser(ctx context.Context, id string) (*zoom.UsersGetResponse, error) {
+	user, res, err := h.users.Get(ctx, id, nil)
+	if err != nil {
+		return nil, zoomError(err, res)
+	}
+
+	return user, nil
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	var users []*User
+
+	if query := r.URL.Query().Get("filter"); len(query) > 0 {
+		f, err := parseFilter(query)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		switch f.attribute {
+		case "username", "emails.value", "id":
+		default:
+			writeError(w, newError(http.StatusBadRequest, "invalidFilter", "users can only be filtered by id or userName"))
+			return
+		}
+
+		user, res, err := h.users.Get(r.Context(), f.value, nil)
+		if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+			writeError(w, zoomError(err, res))
+			return
+		}
+
+		// Zoom looks users up by ID or email, so check the user found matches the filtered attribute.
+		if err == nil && (f.attribute == "id" && user.ID == f.value || f.attribute != "id" && strings.EqualFold(user.Email, f.value)) {
+			users = append(users, userFromZoom(&user.UsersListItem))
+		}
+	} else {
+		for _, status := range listStatuses {
+			opts := &zoom.UsersListOptions{
+				PaginationOptions: &zoom.PaginationOptions{
+					PageSize: zoom.Ptr(300),
+				},
+				Status: zoom.Ptr(status),
+			}
+
+			for {
+				out, res, err := h.users.List(r.Context(), opts)
+				if err != nil {
+					writeError(w, zoomError(err, res))
+					return
+				}
+
+				for _, user := range out.Users {
+					users = append(users, userFromZoom(user))
+				}
+
+				if out.PaginationResponse == nil || len(out.NextPageToken) == 0 {
+					break
+				}
+
+				opts.NextPageToken = zoom.Ptr(out.NextPageToken)
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, listResponse(r, users))
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.zoomUser(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, userFromZoom(&user.UsersListItem))
+}
+
+// createUser creates the user and then applies the attributes Zoom does not accept on creation. The active attribute
+// is ignored, as users stay pending until they accept their invitation.
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	user := &User{}
+	err := decode(r, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	email := user.email()
+	if len(email) == 0 {
+		writeError(w, newError(http.StatusBadRequest, "invalidValue", "userName is required"))
+		return
+	}
+
+	userInfo := &zoom.UsersCreateOptionsUserInfo{
+		Email: email,
+		Type:  1,
+	}
+
+	if user.Name != nil {
+		if len(user.Name.GivenName) > 0 {
+			userInfo.FirstName = zoom.Ptr(user.Name.GivenName)
+		}
+
+		if len(user.Name.FamilyName) > 0 {
+			userInfo.LastName = zoom.Ptr(user.Name.FamilyName)
+		}
+	}
+
+	if len(user.DisplayName) > 0 {
+		userInfo.DisplayName = zoom.Ptr(user.DisplayName)
+	}
+
+	if len(user.UserType) > 0 {
+		userInfo.Type, err = zoomUserType(user.UserType)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	created, res, err := h.users.Create(r.Context(), &zoom.UsersCreateOptions{
+		Action:   h.CreateAction,
+		UserInfo: userInfo,
+	})
+	if err != nil {
+		writeError(w, zoomError(err, res))
+		return
+	}
+
+	if user.Enterprise != nil && len(user.Enterprise.Department) > 0 {
+		res, err = h.users.Update(r.Context(), created.ID, &zoom.UsersUpdateOptions{
+			Dept: zoom.Ptr(user.Enterprise.Department),
+		})
+		if err != nil {
+			writeError(w, zoomError(err, res))
+			return
+		}
+	}
+
+	current, err := h.zoomUser(r.Context(), created.ID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, userFromZoom(&current.UsersListItem))
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	current, err := h.zoomUser(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	user := &User{}
+	err = decode(r, user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// A replace clears the mutable attributes left out of the body, unlike a patch which leaves them as they are.
+	if user.Name == nil {
+		user.Name = &Name{}
+	}
+
+	if user.Enterprise == nil {
+		user.Enterprise = &EnterpriseUser{}
+	}
+
+	h.updateUser(w, r, current, user)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	current, err := h.zoomUser(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	patch := &PatchRequest{}
+	err = decode(r, patch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	user := userFromZoom(&current.UsersListItem)
+	for _, op := range patch.Operations {
+		err = patchUser(user, op)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	h.updateUser(w, r, current, user)
+}
+
+// updateUser updates the Zoom user to match the desired user, activating or deactivating it when the active attribute
+// changes, and writes back the updated user.
+func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request, current *zoom.UsersGetResponse, user *User) {
+	email := user.email()
+	if len(email) > 0 && !strings.EqualFold(email, current.Email) {
+		writeError(w, newError(http.StatusBadRequest, "mutability", "userName cannot be changed"))
+		return
+	}
+
+	opts := &zoom.UsersUpdateOptions{}
+	changed := false
+
+	if user.Name != nil && user.Name.GivenName != current.FirstName {
+		opts.FirstName = zoom.Ptr(user.Name.GivenName)
+		changed = true
+	}
+
+	if user.Name != nil && user.Name.FamilyName != current.LastName {
+		opts.LastName = zoom.Ptr(user.Name.FamilyName)
+		changed = true
+	}
+
+	if user.DisplayName != current.DisplayName {
+		opts.DisplayName = zoom.Ptr(user.DisplayName)
+		changed = true
+	}
+
+	if user.Enterprise != nil && user.Enterprise.Department != current.Dept {
+		opts.Dept = zoom.Ptr(user.Enterprise.Department)
+		changed = true
+	}
+
+	if len(user.UserType) > 0 {
+		t, err := zoomUserType(user.UserType)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if t != current.Type {
+			opts.Type = zoom.Ptr(t)
+			changed = true
+		}
+	}
+
+	if changed {
+		res, err := h.users.Update(r.Context(), current.ID, opts)
+		if err != nil {
+			writeError(w, zoomError(err, res))
+			return
+		}
+	}
+
+	if user.Active != nil && *user.Active != (current.Status != statusInactive) {
+		action := zoom.UsersStatusActionDeactivate
+		if *user.Active {
+			action = zoom.UsersStatusActionActivate
+		}
+
+		res, err := h.users.UpdateStatus(r.Context(), current.ID, &zoom.UsersUpdateStatusOptions{
+			Action: action,
+		})
+		if err != nil {
+			writeError(w, zoomError(err, res))
+			return
+		}
+	}
+
+	updated, err := h.zoomUser(r.Context(), current.ID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, userFromZoom(&updated.UsersListItem))
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	res, err := h.users.Delete(r.Context(), r.PathValue("id"), nil)
+	if err != nil {
+		writeError(w, zoomError(err, res))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// patchUser applies a single patch operation to user. Operations on emails are ignored, as a user's email is its
+// userName and cannot be changed.
+func patchUser(user *User, op *PatchOperation) error {
+	kind := strings.ToLower(op.Op)
+	switch kind {
+	case "add", "replace", "remove":
+	default:
+		return newError(http.StatusBadRequest, "invalidSyntax", "unsupported patch op "+strconv.Quote(op.Op))
+	}
+
+	if len(op.Path) == 0 {
+		if kind == "remove" {
+			return newError(http.StatusBadRequest, "noTarget", "remove requires a path")
+		}
+
+		values, ok := op.Value.(map[string]any)
+		if !ok {
+			return newError(http.StatusBadRequest, "invalidValue", "patch value without a path must be an object")
+		}
+
+		for attribute, value := range values {
+			if nested, ok := value.(map[string]any); ok {
+				for subAttribute, subValue := range nested {
+					err := patchUserAttribute(user, kind, attribute+"."+subAttribute, subValue)
+					if err != nil {
+						return err
+					}
+				}
+
+				continue
+			}
+
+			err := patchUserAttribute(user, kind, attribute, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return patchUserAttribute(user, kind, op.Path, op.Value)
+}
+
+func patchUserAttribute(user *User, kind string, path string, value any) error {
+	path = strings.ToLower(path)
+
+	// The enterprise extension's attributes are prefixed by its schema, followed by either a colon or, when nested in a
+	// path-less value, a dot.
+	enterprise := strings.ToLower(SchemaEnterpriseUser)
+	if strings.HasPrefix(path, enterprise+":") || strings.HasPrefix(path, enterprise+".") {
+		path = "enterprise." + path[len(enterprise)+1:]
+	}
+
+	if strings.HasPrefix(path, "emails") {
+		return nil
+	}
+
+	if path == "active" {
+		if kind == "remove" {
+			return newError(http.StatusBadRequest, "mutability", "active cannot be removed")
+		}
+
+		active, err := boolValue(value)
+		if err != nil {
+			return err
+		}
+
+		user.Active = &active
+
+		return nil
+	}
+
+	s := ""
+	if kind != "remove" {
+		var err error
+		s, err = stringValue(path, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch path {
+	case "username":
+		user.UserName = s
+	case "displayname":
+		user.DisplayName = s
+	case "usertype":
+		user.UserType = s
+	case "name.givenname":
+		if user.Name == nil {
+			user.Name = &Name{}
+		}
+		user.Name.GivenName = s
+	case "name.familyname":
+		if user.Name == nil {
+			user.Name = &Name{}
+		}
+		user.Name.FamilyName = s
+	case "name.formatted":
+	case "enterprise.department":
+		if user.Enterprise == nil {
+			user.Enterprise = &EnterpriseUser{}
+		}
+		user.Enterprise.Department = s
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported path "+strconv.Quote(path))
+	}
+
+	return nil
+}
+
+func stringValue(path string, value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", newError(http.StatusBadRequest, "invalidValue", path+" must be a string")
+	}
+
+	return s, nil
+}
+
+// boolValue accepts booleans as well as the "True" and "False" strings sent by some identity providers.
+func boolValue(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err == nil {
+			return b, nil
+		}
+	}
+
+	return false, newError(http.StatusBadRequest, "invalidValue", "active must be a boolean")
+}
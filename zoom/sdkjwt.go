@@ -0,0 +1,433 @@
+package zoom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	SDKRoleParticipant SDKRole = 0
+	SDKRoleHost        SDKRole = 1
+
+	// SDKJWTMinExpiration and SDKJWTMaxExpiration bound how long Zoom accepts an SDK JWT for.
+	SDKJWTMinExpiration     = 30 * time.Minute
+	SDKJWTMaxExpiration     = 48 * time.Hour
+	SDKJWTDefaultExpiration = 24 * time.Hour
+
+	videoSDKMaxSessionNameLength  = 200
+	videoSDKMaxSessionKeyLength   = 36
+	videoSDKMaxUserIdentityLength = 35
+)
+
+var (
+	ErrInvalidSDKClaims = errors.New("invalid SDK JWT claims")
+
+	// VideoSDKGeoRegions are the regions a Video SDK session can be limited to.
+	VideoSDKGeoRegions = []string{"AU", "BR", "CA", "CN", "DE", "HK", "IN", "JP", "MX", "NL", "SG", "US"}
+)
+
+// SDKRole is the role a user joins a Meeting SDK meeting or Video SDK session with.
+type SDKRole int
+
+func (s SDKRole) Int() int {
+	return int(s)
+}
+
+// MeetingSDKClaims are the claims of a Meeting SDK JWT (see
+// https://developers.zoom.us/docs/meeting-sdk/auth/#generate-a-meeting-sdk-jwt).
+type MeetingSDKClaims struct {
+	MeetingNumber int64
+	// Role is required for web, optional for native.
+	Role SDKRole
+	// VideoWebRTCMode enables WebRTC video for the web Meeting SDK.
+	VideoWebRTCMode *bool
+
+	// IssuedAt defaults to now when signing.
+	IssuedAt time.Time
+	// Expiration is how long the JWT is valid after IssuedAt, between SDKJWTMinExpiration and SDKJWTMaxExpiration. It
+	// defaults to SDKJWTDefaultExpiration.
+	Expiration time.Duration
+}
+
+// NewMeetingSDKClaims returns the claims to join the meeting with the given role.
+func NewMeetingSDKClaims(meetingNumber int64, role SDKRole) *MeetingSDKClaims {
+	return &MeetingSDKClaims{
+		MeetingNumber: meetingNumber,
+		Role:          role,
+	}
+}
+
+// ExpiresIn sets how long the JWT is valid.
+func (c *MeetingSDKClaims) ExpiresIn(d time.Duration) *MeetingSDKClaims {
+	c.Expiration = d
+	return c
+}
+
+// WithVideoWebRTCMode sets whether the web Meeting SDK uses WebRTC for video.
+func (c *MeetingSDKClaims) WithVideoWebRTCMode(enabled bool) *MeetingSDKClaims {
+	c.VideoWebRTCMode = Ptr(enabled)
+	return c
+}
+
+func (c *MeetingSDKClaims) Validate() error {
+	err := validateSDKRole(c.Role)
+	if err != nil {
+		return err
+	}
+
+	return validateSDKExpiration(c.Expiration)
+}
+
+// Sign validates the claims and returns the JWT signed with the Meeting SDK key and secret.
+func (c *MeetingSDKClaims) Sign(sdkKey, sdkSecret string) (string, error) {
+	err := c.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	iat, exp := sdkJWTTimes(c.IssuedAt, c.Expiration)
+
+	claims := jwt.MapClaims{
+		"appKey":   sdkKey,
+		"sdkKey":   sdkKey,
+		"mn":       c.MeetingNumber,
+		"role":     c.Role.Int(),
+		"iat":      iat,
+		"exp":      exp,
+		"tokenExp": exp,
+	}
+
+	if c.VideoWebRTCMode != nil {
+		claims["videoWebRtcMode"] = boolInt(*c.VideoWebRTCMode)
+	}
+
+	return signSDKJWT(claims, sdkSecret)
+}
+
+// VideoSDKClaims are the claims of a Video SDK JWT (see https://developers.zoom.us/docs/video-sdk/auth/).
+type VideoSDKClaims struct {
+	// SessionName is the session's topic, which identifies the session.
+	SessionName string
+	Role        SDKRole
+	// SessionKey is an optional passcode for the session.
+	SessionKey string
+	// UserIdentity is an optional identifier for the user, returned by the Video SDK API.
+	UserIdentity string
+	// GeoRegions limits the regions the session's data centers are in to VideoSDKGeoRegions.
+	GeoRegions []string
+	// CloudRecordingOption records each user's video in its own file instead of one file for the session.
+	CloudRecordingOption *bool
+	// CloudRecordingElection makes the user's cloud recording the one that is kept.
+	CloudRecordingElection *bool
+
+	// IssuedAt defaults to now when signing.
+	IssuedAt time.Time
+	// Expiration is how long the JWT is valid after IssuedAt, between SDKJWTMinExpiration and SDKJWTMaxExpiration. It
+	// defaults to SDKJWTDefaultExpiration.
+	Expiration time.Duration
+}
+
+// NewVideoSDKClaims returns the claims to join the named session with the given role.
+func NewVideoSDKClaims(sessionName string, role SDKRole) *VideoSDKClaims {
+	return &VideoSDKClaims{
+		SessionName: sessionName,
+		Role:        role,
+	}
+}
+
+// ExpiresIn sets how long the JWT is valid.
+func (c *VideoSDKClaims) ExpiresIn(d time.Duration) *VideoSDKClaims {
+	c.Expiration = d
+	return c
+}
+
+// WithSessionKey sets the session's passcode.
+func (c *VideoSDKClaims) WithSessionKey(key string) *VideoSDKClaims {
+	c.SessionKey = key
+	return c
+}
+
+// WithUserIdentity sets the user's identifier.
+func (c *VideoSDKClaims) WithUserIdentity(identity string) *VideoSDKClaims {
+	c.UserIdentity = identity
+	return c
+}
+
+// InRegions limits the session to data centers in the given regions.
+func (c *VideoSDKClaims) InRegions(regions ...string) *VideoSDKClaims {
+	c.GeoRegions = regions
+	return c
+}
+
+// WithCloudRecording sets whether each user's video is recorded separately and whether the user's recording is kept.
+func (c *VideoSDKClaims) WithCloudRecording(separateFiles, election bool) *VideoSDKClaims {
+	c.CloudRecordingOption = Ptr(separateFiles)
+	c.CloudRecordingElection = Ptr(election)
+	return c
+}
+
+func (c *VideoSDKClaims) Validate() error {
+	if len(c.SessionName) == 0 {
+		return fmt.Errorf("%w: missing session name", ErrInvalidSDKClaims)
+	}
+
+	if len(c.SessionName) > videoSDKMaxSessionNameLength {
+		return fmt.Errorf("%w: session name is longer than %d characters", ErrInvalidSDKClaims, videoSDKMaxSessionNameLength)
+	}
+
+	if len(c.SessionKey) > videoSDKMaxSessionKeyLength {
+		return fmt.Errorf("%w: session key is longer than %d characters", ErrInvalidSDKClaims, videoSDKMaxSessionKeyLength)
+	}
+
+	if len(c.UserIdentity) > videoSDKMaxUserIdentityLength {
+		return fmt.Errorf("%w: user identity is longer than %d characters", ErrInvalidSDKClaims, videoSDKMaxUserIdentityLength)
+	}
+
+	for _, region := range c.GeoRegions {
+		if !slices.Contains(VideoSDKGeoRegions, region) {
+			return fmt.Errorf("%w: unknown geo region %q", ErrInvalidSDKClaims, region)
+		}
+	}
+
+	err := validateSDKRole(c.Role)
+	if err != nil {
+		return err
+	}
+
+	return validateSDKExpiration(c.Expiration)
+}
+
+// Sign validates the claims and returns the JWT signed with the Video SDK key and secret.
+func (c *VideoSDKClaims) Sign(sdkKey, sdkSecret string) (string, error) {
+	err := c.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	iat, exp := sdkJWTTimes(c.IssuedAt, c.Expiration)
+
+	claims := jwt.MapClaims{
+		"app_key":   sdkKey,
+		"version":   1,
+		"tpc":       c.SessionName,
+		"role_type": c.Role.Int(),
+		"iat":       iat,
+		"exp":       exp,
+	}
+
+	if len(c.SessionKey) > 0 {
+		claims["session_key"] = c.SessionKey
+	}
+
+	if len(c.UserIdentity) > 0 {
+		claims["user_identity"] = c.UserIdentity
+	}
+
+	if len(c.GeoRegions) > 0 {
+		claims["geo_regions"] = strings.Join(c.GeoRegions, ",")
+	}
+
+	if c.CloudRecordingOption != nil {
+		claims["cloud_recording_option"] = boolInt(*c.CloudRecordingOption)
+	}
+
+	if c.CloudRecordingElection != nil {
+		claims["cloud_recording_election"] = boolInt(*c.CloudRecordingElection)
+	}
+
+	return signSDKJWT(claims, sdkSecret)
+}
+
+// MeetingSDKJWT creates a Meeting SDK JWT, signs it, and returns the signed string (see https://marketplace.zoom.us/docs/sdk/native-sdks/auth/#meeting-sdk-auth).
+// role is required for web, optional for native. 0 to specify participant or 1 to specify host.
+// expiration is the duration or expiration of JWT from now. Minimum duration is 1800 seconds, maximum duration is 48 hours. Default duration is 24 hours.
+// Use MeetingSDKClaims to set other claims.
+func MeetingSDKJWT(meetingSDKKey, meetingSDKSecret string, meetingNumber int64, role int, expiration time.Duration) (string, error) {
+	return NewMeetingSDKClaims(meetingNumber, SDKRole(role)).ExpiresIn(expiration).Sign(meetingSDKKey, meetingSDKSecret)
+}
+
+// VerifyMeetingSDKJWT checks a Meeting SDK JWT was signed with the SDK key and secret, hasn't expired and has valid
+// claims, and returns its claims.
+func VerifyMeetingSDKJWT(tokenStr, sdkKey, sdkSecret string) (*MeetingSDKClaims, error) {
+	claims, err := parseSDKJWT(tokenStr, sdkSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.string("appKey") != sdkKey && claims.string("sdkKey") != sdkKey {
+		return nil, fmt.Errorf("%w: signed for another SDK key", ErrInvalidSDKClaims)
+	}
+
+	c := &MeetingSDKClaims{
+		MeetingNumber: claims.int("mn"),
+	}
+
+	// The role is optional for native Meeting SDKs, which join as participants without it.
+	if _, ok := claims["role"]; ok {
+		c.Role = SDKRole(claims.int("role"))
+	}
+
+	c.IssuedAt, c.Expiration, err = claims.times()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := claims["videoWebRtcMode"]; ok {
+		c.VideoWebRTCMode = Ptr(claims.int("videoWebRtcMode") == 1)
+	}
+
+	err = c.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// VerifyVideoSDKJWT checks a Video SDK JWT was signed with the SDK key and secret, hasn't expired and has valid claims,
+// and returns its claims.
+func VerifyVideoSDKJWT(tokenStr, sdkKey, sdkSecret string) (*VideoSDKClaims, error) {
+	claims, err := parseSDKJWT(tokenStr, sdkSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.string("app_key") != sdkKey {
+		return nil, fmt.Errorf("%w: signed for another SDK key", ErrInvalidSDKClaims)
+	}
+
+	c := &VideoSDKClaims{
+		SessionName:  claims.string("tpc"),
+		Role:         SDKRole(claims.int("role_type")),
+		SessionKey:   claims.string("session_key"),
+		UserIdentity: claims.string("user_identity"),
+	}
+
+	c.IssuedAt, c.Expiration, err = claims.times()
+	if err != nil {
+		return nil, err
+	}
+
+	if regions := claims.string("geo_regions"); len(regions) > 0 {
+		c.GeoRegions = strings.Split(regions, ",")
+	}
+
+	if _, ok := claims["cloud_recording_option"]; ok {
+		c.CloudRecordingOption = Ptr(claims.int("cloud_recording_option") == 1)
+	}
+
+	if _, ok := claims["cloud_recording_election"]; ok {
+		c.CloudRecordingElection = Ptr(claims.int("cloud_recording_election") == 1)
+	}
+
+	err = c.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type sdkClaims jwt.MapClaims
+
+func parseSDKJWT(tokenStr, sdkSecret string) (sdkClaims, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(sdkSecret), nil
+	}, jwt.WithJSONNumber(), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, fmt.Errorf("parsing JWT: %w", err)
+	}
+
+	return sdkClaims(claims), nil
+}
+
+func (s sdkClaims) string(name string) string {
+	str, _ := s[name].(string)
+	return str
+}
+
+func (s sdkClaims) int(name string) int64 {
+	n, ok := s[name].(json.Number)
+	if !ok {
+		return -1
+	}
+
+	i, err := n.Int64()
+	if err != nil {
+		return -1
+	}
+
+	return i
+}
+
+// times returns when the JWT was issued and how long it is valid for.
+func (s sdkClaims) times() (time.Time, time.Duration, error) {
+	iat := s.int("iat")
+	exp := s.int("exp")
+	if iat < 0 || exp < 0 {
+		return time.Time{}, 0, fmt.Errorf("%w: missing iat or exp", ErrInvalidSDKClaims)
+	}
+
+	return time.Unix(iat, 0), time.Duration(exp-iat) * time.Second, nil
+}
+
+func validateSDKRole(role SDKRole) error {
+	if role != SDKRoleParticipant && role != SDKRoleHost {
+		return fmt.Errorf("%w: unknown role %d", ErrInvalidSDKClaims, role)
+	}
+
+	return nil
+}
+
+func validateSDKExpiration(d time.Duration) error {
+	if d == 0 {
+		return nil
+	}
+
+	if d < SDKJWTMinExpiration || d > SDKJWTMaxExpiration {
+		return fmt.Errorf("%w: expiration %s is not between %s and %s", ErrInvalidSDKClaims, d, SDKJWTMinExpiration, SDKJWTMaxExpiration)
+	}
+
+	return nil
+}
+
+// sdkJWTTimes returns the iat and exp claims, defaulting to issuing the JWT now for SDKJWTDefaultExpiration.
+func sdkJWTTimes(issuedAt time.Time, expiration time.Duration) (int64, int64) {
+	if issuedAt.IsZero() {
+		issuedAt = time.Now()
+	}
+
+	if expiration == 0 {
+		expiration = SDKJWTDefaultExpiration
+	}
+
+	return issuedAt.Unix(), issuedAt.Add(expiration).Unix()
+}
+
+func signSDKJWT(claims jwt.MapClaims, sdkSecret string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	// Sign and get the complete encoded token as a string using the secret
+	tokenStr, err := token.SignedString([]byte(sdkSecret))
+	if err != nil {
+		return "", fmt.Errorf("signing JWT: %w", err)
+	}
+
+	return tokenStr, nil
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,124 @@
+package zoom
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeetingSDKJWT_Expiration(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := MeetingSDKJWT("key", "secret", 123, 0, 10*time.Minute)
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+
+	_, err = MeetingSDKJWT("key", "secret", 123, 0, 72*time.Hour)
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+
+	_, err = MeetingSDKJWT("key", "secret", 123, 2, time.Hour)
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+}
+
+func TestMeetingSDKClaims(t *testing.T) {
+	assert := assert.New(t)
+
+	issuedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	claims := NewMeetingSDKClaims(85746065432, SDKRoleHost).ExpiresIn(2 * time.Hour).WithVideoWebRTCMode(true)
+	claims.IssuedAt = issuedAt
+
+	tokenStr, err := claims.Sign("key", "secret")
+	assert.NoError(err)
+
+	verified, err := VerifyMeetingSDKJWT(tokenStr, "key", "secret")
+	assert.NoError(err)
+	assert.Equal(claims, verified)
+
+	token, _, err := jwt.NewParser().ParseUnverified(tokenStr, jwt.MapClaims{})
+	assert.NoError(err)
+	assert.Equal(float64(1), token.Claims.(jwt.MapClaims)["videoWebRtcMode"])
+
+	_, err = VerifyMeetingSDKJWT(tokenStr, "key", "wrong")
+	assert.ErrorContains(err, "signature is invalid")
+
+	_, err = VerifyMeetingSDKJWT(tokenStr, "other", "secret")
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+
+	claims.IssuedAt = time.Now().Add(-3 * time.Hour)
+	tokenStr, err = claims.Sign("key", "secret")
+	assert.NoError(err)
+
+	_, err = VerifyMeetingSDKJWT(tokenStr, "key", "secret")
+	assert.ErrorContains(err, "Token is expired")
+
+	// A JWT valid for longer than Zoom allows is rejected even when it is signed correctly.
+	tokenStr, err = signSDKJWT(jwt.MapClaims{
+		"appKey": "key",
+		"mn":     123,
+		"iat":    time.Now().Unix(),
+		"exp":    time.Now().Add(72 * time.Hour).Unix(),
+	}, "secret")
+	assert.NoError(err)
+
+	_, err = VerifyMeetingSDKJWT(tokenStr, "key", "secret")
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+
+	tokenStr, err = jwt.NewWithClaims(jwt.SigningMethodHS512, jwt.MapClaims{"appKey": "key"}).SignedString([]byte("secret"))
+	assert.NoError(err)
+
+	_, err = VerifyMeetingSDKJWT(tokenStr, "key", "secret")
+	assert.ErrorContains(err, "signing method HS512 is invalid")
+}
+
+func TestVideoSDKClaims(t *testing.T) {
+	assert := assert.New(t)
+
+	issuedAt := time.Now().Truncate(time.Second)
+
+	claims := NewVideoSDKClaims("support-1234", SDKRoleParticipant).
+		WithSessionKey("passcode").
+		WithUserIdentity("user-42").
+		InRegions("US", "CA").
+		WithCloudRecording(true, false)
+	claims.IssuedAt = issuedAt
+
+	tokenStr, err := claims.Sign("key", "secret")
+	assert.NoError(err)
+
+	token, _, err := jwt.NewParser().ParseUnverified(tokenStr, jwt.MapClaims{})
+	assert.NoError(err)
+
+	mapClaims := token.Claims.(jwt.MapClaims)
+	assert.Equal("key", mapClaims["app_key"])
+	assert.Equal("support-1234", mapClaims["tpc"])
+	assert.Equal(float64(0), mapClaims["role_type"])
+	assert.Equal("US,CA", mapClaims["geo_regions"])
+	assert.Equal(float64(1), mapClaims["cloud_recording_option"])
+	assert.Equal(float64(0), mapClaims["cloud_recording_election"])
+	assert.Equal(float64(issuedAt.Add(SDKJWTDefaultExpiration).Unix()), mapClaims["exp"])
+
+	verified, err := VerifyVideoSDKJWT(tokenStr, "key", "secret")
+	assert.NoError(err)
+
+	claims.Expiration = SDKJWTDefaultExpiration
+	assert.Equal(claims, verified)
+
+	_, err = VerifyMeetingSDKJWT(tokenStr, "key", "secret")
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+
+	for _, invalid := range []*VideoSDKClaims{
+		NewVideoSDKClaims("", SDKRoleHost),
+		NewVideoSDKClaims(strings.Repeat("a", 201), SDKRoleHost),
+		NewVideoSDKClaims("a", SDKRoleHost).WithSessionKey(strings.Repeat("a", 37)),
+		NewVideoSDKClaims("a", SDKRoleHost).WithUserIdentity(strings.Repeat("a", 36)),
+		NewVideoSDKClaims("a", SDKRoleHost).InRegions("EU"),
+		NewVideoSDKClaims("a", SDKRole(3)),
+		NewVideoSDKClaims("a", SDKRoleHost).ExpiresIn(time.Minute),
+	} {
+		_, err = invalid.Sign("key", "secret")
+		assert.ErrorIs(err, ErrInvalidSDKClaims)
+	}
+}
@@ -0,0 +1,25 @@
+package zoom
+
+// SettingsSection holds the settings of a single section, keyed by their Zoom field name. Sections have many fields that vary
+// between plans and change frequently, so they are left untyped.
+type SettingsSection map[string]any
+
+// Settings is the shape shared by account, group and user settings and lock settings. It is used both to read and to update
+// settings; when updating, only the sections and fields that are set are changed.
+type Settings struct {
+	AudioConferencing SettingsSection `json:"audio_conferencing,omitempty"`
+	Chat              SettingsSection `json:"chat,omitempty"`
+	EmailNotification SettingsSection `json:"email_notification,omitempty"`
+	Feature           SettingsSection `json:"feature,omitempty"`
+	InMeeting         SettingsSection `json:"in_meeting,omitempty"`
+	Integration       SettingsSection `json:"integration,omitempty"`
+	MeetingSecurity   SettingsSection `json:"meeting_security,omitempty"`
+	OtherOptions      SettingsSection `json:"other_options,omitempty"`
+	Profile           SettingsSection `json:"profile,omitempty"`
+	Recording         SettingsSection `json:"recording,omitempty"`
+	ScheduleMeeting   SettingsSection `json:"schedule_meeting,omitempty"`
+	Security          SettingsSection `json:"security,omitempty"`
+	Telephony         SettingsSection `json:"telephony,omitempty"`
+	TSP               SettingsSection `json:"tsp,omitempty"`
+	ZoomRooms         SettingsSection `json:"zoom_rooms,omitempty"`
+}
@@ -0,0 +1,146 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	SIPTransportProtocolUDP  SIPTransportProtocol = "UDP"
+	SIPTransportProtocolTCP  SIPTransportProtocol = "TCP"
+	SIPTransportProtocolTLS  SIPTransportProtocol = "TLS"
+	SIPTransportProtocolAuto SIPTransportProtocol = "AUTO"
+)
+
+type SIPTransportProtocol string
+
+func (s SIPTransportProtocol) String() string {
+	return string(s)
+}
+
+type SIPPhonesServicer interface {
+	List(ctx context.Context, opts *SIPPhonesListOptions) (*SIPPhonesListResponse, *http.Response, error)
+	Enable(ctx context.Context, opts *SIPPhonesEnableOptions) (*http.Response, error)
+	Update(ctx context.Context, phoneID string, opts *SIPPhonesUpdateOptions) (*http.Response, error)
+	Delete(ctx context.Context, phoneID string) (*http.Response, error)
+}
+
+// SIPPhonesService covers the SIP phones users of the account make calls with from the Zoom client, registered with
+// the account's own SIP servers rather than Zoom Phone.
+type SIPPhonesService struct {
+	client *Client
+}
+
+var _ SIPPhonesServicer = (*SIPPhonesService)(nil)
+
+type SIPPhonesListOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	// SearchKey filters phones by user name or email address.
+	SearchKey *string `url:"search_key,omitempty"`
+}
+
+type SIPPhonesListResponse struct {
+	*PaginationResponse
+
+	Phones []*SIPPhone `json:"phones"`
+}
+
+type SIPPhone struct {
+	AuthorizationName      string               `json:"authorization_name"`
+	Domain                 string               `json:"domain"`
+	PhoneID                string               `json:"phone_id"`
+	ProxyServer            string               `json:"proxy_server"`
+	ProxyServer2           string               `json:"proxy_server2"`
+	ProxyServer3           string               `json:"proxy_server3"`
+	RegisterServer         string               `json:"register_server"`
+	RegisterServer2        string               `json:"register_server2"`
+	RegisterServer3        string               `json:"register_server3"`
+	RegistrationExpireTime int                  `json:"registration_expire_time"`
+	TransportProtocol      SIPTransportProtocol `json:"transport_protocol"`
+	TransportProtocol2     SIPTransportProtocol `json:"transport_protocol2"`
+	TransportProtocol3     SIPTransportProtocol `json:"transport_protocol3"`
+	UserEmail              string               `json:"user_email"`
+	UserName               string               `json:"user_name"`
+	VoiceMail              string               `json:"voice_mail"`
+}
+
+func (s *SIPPhonesService) List(ctx context.Context, opts *SIPPhonesListOptions) (*SIPPhonesListResponse, *http.Response, error) {
+	out := &SIPPhonesListResponse{}
+
+	res, err := s.client.request(ctx, http.MethodGet, "/sip_phones", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type SIPPhonesEnableOptions struct {
+	AuthorizationName string  `json:"authorization_name"`
+	Domain            string  `json:"domain"`
+	Password          string  `json:"password"`
+	ProxyServer       string  `json:"proxy_server"`
+	ProxyServer2      *string `json:"proxy_server2,omitempty"`
+	ProxyServer3      *string `json:"proxy_server3,omitempty"`
+	RegisterServer    string  `json:"register_server"`
+	RegisterServer2   *string `json:"register_server2,omitempty"`
+	RegisterServer3   *string `json:"register_server3,omitempty"`
+	// RegistrationExpireTime is how many minutes a registration lasts, from 1 to 127. Zoom defaults to 60.
+	RegistrationExpireTime *int                  `json:"registration_expire_time,omitempty"`
+	TransportProtocol      *SIPTransportProtocol `json:"transport_protocol,omitempty"`
+	TransportProtocol2     *SIPTransportProtocol `json:"transport_protocol2,omitempty"`
+	TransportProtocol3     *SIPTransportProtocol `json:"transport_protocol3,omitempty"`
+	UserEmail              string                `json:"user_email"`
+	UserName               string                `json:"user_name"`
+	VoiceMail              string                `json:"voice_mail"`
+}
+
+// Enable enables SIP phone calling for the user with UserEmail.
+func (s *SIPPhonesService) Enable(ctx context.Context, opts *SIPPhonesEnableOptions) (*http.Response, error) {
+	res, err := s.client.request(ctx, http.MethodPost, "/sip_phones", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type SIPPhonesUpdateOptions struct {
+	AuthorizationName      *string               `json:"authorization_name,omitempty"`
+	Domain                 *string               `json:"domain,omitempty"`
+	Password               *string               `json:"password,omitempty"`
+	ProxyServer            *string               `json:"proxy_server,omitempty"`
+	ProxyServer2           *string               `json:"proxy_server2,omitempty"`
+	ProxyServer3           *string               `json:"proxy_server3,omitempty"`
+	RegisterServer         *string               `json:"register_server,omitempty"`
+	RegisterServer2        *string               `json:"register_server2,omitempty"`
+	RegisterServer3        *string               `json:"register_server3,omitempty"`
+	RegistrationExpireTime *int                  `json:"registration_expire_time,omitempty"`
+	TransportProtocol      *SIPTransportProtocol `json:"transport_protocol,omitempty"`
+	TransportProtocol2     *SIPTransportProtocol `json:"transport_protocol2,omitempty"`
+	TransportProtocol3     *SIPTransportProtocol `json:"transport_protocol3,omitempty"`
+	UserName               *string               `json:"user_name,omitempty"`
+	VoiceMail              *string               `json:"voice_mail,omitempty"`
+}
+
+func (s *SIPPhonesService) Update(ctx context.Context, phoneID string, opts *SIPPhonesUpdateOptions) (*http.Response, error) {
+	res, err := s.client.request(ctx, http.MethodPatch, "/sip_phones/"+url.QueryEscape(phoneID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// Delete disables SIP phone calling for the phone's user.
+func (s *SIPPhonesService) Delete(ctx context.Context, phoneID string) (*http.Response, error) {
+	res, err := s.client.request(ctx, http.MethodDelete, "/sip_phones/"+url.QueryEscape(phoneID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,288 @@
+package zoom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	startTimeUTCLayout   = "2006-01-02T15:04:05Z"
+	startTimeLocalLayout = "2006-01-02T15:04:05"
+)
+
+var ErrUnsupportedTimezone = errors.New("timezone is not supported by Zoom")
+
+// StartTime is the start time of a meeting or webinar. Without a Timezone it is sent to Zoom in UTC. With a Timezone
+// it is sent as the wall-clock time in that timezone, which Zoom requires for recurring meetings to keep their local
+// time across daylight saving time changes. The create or update options' Timezone is then set to the same timezone,
+// and sending them fails when it is set to another one.
+type StartTime struct {
+	time.Time
+
+	Timezone string
+}
+
+// NewStartTime returns a start time that is sent to Zoom in UTC.
+func NewStartTime(t time.Time) *StartTime {
+	return &StartTime{Time: t}
+}
+
+// NewLocalStartTime returns a start time that is sent to Zoom as the wall-clock time of t in timezone, which must be
+// one of the timezones supported by Zoom.
+func NewLocalStartTime(t time.Time, timezone string) (*StartTime, error) {
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartTime{Time: t.In(loc), Timezone: timezone}, nil
+}
+
+func (s StartTime) MarshalJSON() ([]byte, error) {
+	if s.IsZero() {
+		return json.Marshal("")
+	}
+
+	if len(s.Timezone) == 0 {
+		return json.Marshal(s.UTC().Format(startTimeUTCLayout))
+	}
+
+	loc, err := LoadTimezone(s.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(s.In(loc).Format(startTimeLocalLayout))
+}
+
+// UnmarshalJSON accepts the formats Zoom returns start times in: UTC, with an offset, or as a wall-clock time without
+// one. A wall-clock time is read in the start time's Timezone when it is set and in UTC otherwise. An empty string, which
+// Zoom returns for recurring meetings with no fixed time, leaves the time zero.
+func (s *StartTime) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err != nil {
+		return fmt.Errorf("decoding start time: %w", err)
+	}
+
+	if len(str) == 0 {
+		s.Time = time.Time{}
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err == nil {
+		s.Time = t
+		return nil
+	}
+
+	loc := time.UTC
+	if len(s.Timezone) > 0 {
+		loc, err = LoadTimezone(s.Timezone)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, layout := range []string{startTimeLocalLayout, "2006-01-02T15:04"} {
+		t, err = time.ParseInLocation(layout, str, loc)
+		if err == nil {
+			s.Time = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("parsing start time %q: unknown format", str)
+}
+
+// ValidateTimezone returns ErrUnsupportedTimezone when Zoom does not support timezone (see
+// https://developers.zoom.us/docs/api/references/abbreviations/#timezones).
+func ValidateTimezone(timezone string) error {
+	if !timezones[timezone] {
+		return fmt.Errorf("%w: %q", ErrUnsupportedTimezone, timezone)
+	}
+
+	return nil
+}
+
+// startTimeTimezone returns the timezone to send with start, which must match the start time's timezone when it has one.
+func startTimeTimezone(start *StartTime, timezone *string) (*string, error) {
+	if start == nil || len(start.Timezone) == 0 {
+		return timezone, nil
+	}
+
+	if timezone == nil {
+		return Ptr(start.Timezone), nil
+	}
+
+	if *timezone != start.Timezone {
+		return nil, fmt.Errorf("timezone %q does not match the start time's timezone %q", *timezone, start.Timezone)
+	}
+
+	return timezone, nil
+}
+
+// LoadTimezone returns the location of a timezone supported by Zoom, including the ones that are not IANA timezones.
+func LoadTimezone(timezone string) (*time.Location, error) {
+	err := ValidateTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := timezoneLocations[timezone]; ok {
+		timezone = name
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("loading timezone: %w", err)
+	}
+
+	return loc, nil
+}
+
+// timezoneLocations maps the timezones supported by Zoom that are not IANA timezones to the IANA timezone they stand
+// for. Zoom lists SST as the Solomon Islands.
+var timezoneLocations = map[string]string{
+	"SST": "Pacific/Guadalcanal",
+}
+
+var timezones = map[string]bool{
+	"Pacific/Midway":                 true,
+	"Pacific/Pago_Pago":              true,
+	"Pacific/Honolulu":               true,
+	"America/Anchorage":              true,
+	"America/Vancouver":              true,
+	"America/Los_Angeles":            true,
+	"America/Tijuana":                true,
+	"America/Edmonton":               true,
+	"America/Denver":                 true,
+	"America/Phoenix":                true,
+	"America/Mazatlan":               true,
+	"America/Winnipeg":               true,
+	"America/Regina":                 true,
+	"America/Chicago":                true,
+	"America/Mexico_City":            true,
+	"America/Guatemala":              true,
+	"America/El_Salvador":            true,
+	"America/Managua":                true,
+	"America/Costa_Rica":             true,
+	"America/Tegucigalpa":            true,
+	"America/Montreal":               true,
+	"America/New_York":               true,
+	"America/Indianapolis":           true,
+	"America/Panama":                 true,
+	"America/Bogota":                 true,
+	"America/Lima":                   true,
+	"America/Halifax":                true,
+	"America/Puerto_Rico":            true,
+	"America/Caracas":                true,
+	"America/Santiago":               true,
+	"America/St_Johns":               true,
+	"America/Montevideo":             true,
+	"America/Araguaina":              true,
+	"America/Argentina/Buenos_Aires": true,
+	"America/Godthab":                true,
+	"America/Sao_Paulo":              true,
+	"Atlantic/Azores":                true,
+	"Canada/Atlantic":                true,
+	"Atlantic/Cape_Verde":            true,
+	"UTC":                            true,
+	"Etc/Greenwich":                  true,
+	"Europe/Belgrade":                true,
+	"CET":                            true,
+	"Atlantic/Reykjavik":             true,
+	"Europe/Dublin":                  true,
+	"Europe/London":                  true,
+	"Europe/Lisbon":                  true,
+	"Africa/Casablanca":              true,
+	"Africa/Nouakchott":              true,
+	"Europe/Oslo":                    true,
+	"Europe/Copenhagen":              true,
+	"Europe/Brussels":                true,
+	"Europe/Berlin":                  true,
+	"Europe/Helsinki":                true,
+	"Europe/Amsterdam":               true,
+	"Europe/Rome":                    true,
+	"Europe/Stockholm":               true,
+	"Europe/Vienna":                  true,
+	"Europe/Luxembourg":              true,
+	"Europe/Paris":                   true,
+	"Europe/Zurich":                  true,
+	"Europe/Madrid":                  true,
+	"Africa/Bangui":                  true,
+	"Africa/Algiers":                 true,
+	"Africa/Tunis":                   true,
+	"Africa/Harare":                  true,
+	"Africa/Nairobi":                 true,
+	"Europe/Warsaw":                  true,
+	"Europe/Prague":                  true,
+	"Europe/Budapest":                true,
+	"Europe/Sofia":                   true,
+	"Europe/Istanbul":                true,
+	"Europe/Athens":                  true,
+	"Europe/Bucharest":               true,
+	"Europe/Kiev":                    true,
+	"Asia/Nicosia":                   true,
+	"Asia/Beirut":                    true,
+	"Asia/Damascus":                  true,
+	"Asia/Jerusalem":                 true,
+	"Asia/Amman":                     true,
+	"Africa/Tripoli":                 true,
+	"Africa/Cairo":                   true,
+	"Africa/Johannesburg":            true,
+	"Europe/Moscow":                  true,
+	"Asia/Baghdad":                   true,
+	"Asia/Kuwait":                    true,
+	"Asia/Riyadh":                    true,
+	"Asia/Bahrain":                   true,
+	"Asia/Qatar":                     true,
+	"Asia/Aden":                      true,
+	"Asia/Tehran":                    true,
+	"Africa/Khartoum":                true,
+	"Africa/Djibouti":                true,
+	"Africa/Mogadishu":               true,
+	"Asia/Dubai":                     true,
+	"Asia/Muscat":                    true,
+	"Asia/Baku":                      true,
+	"Asia/Kabul":                     true,
+	"Asia/Yekaterinburg":             true,
+	"Asia/Tashkent":                  true,
+	"Asia/Calcutta":                  true,
+	"Asia/Kolkata":                   true,
+	"Asia/Kathmandu":                 true,
+	"Asia/Novosibirsk":               true,
+	"Asia/Almaty":                    true,
+	"Asia/Dacca":                     true,
+	"Asia/Dhaka":                     true,
+	"Asia/Krasnoyarsk":               true,
+	"Asia/Bangkok":                   true,
+	"Asia/Saigon":                    true,
+	"Asia/Jakarta":                   true,
+	"Asia/Irkutsk":                   true,
+	"Asia/Shanghai":                  true,
+	"Asia/Hong_Kong":                 true,
+	"Asia/Taipei":                    true,
+	"Asia/Kuala_Lumpur":              true,
+	"Asia/Singapore":                 true,
+	"Australia/Perth":                true,
+	"Asia/Yakutsk":                   true,
+	"Asia/Seoul":                     true,
+	"Asia/Tokyo":                     true,
+	"Australia/Darwin":               true,
+	"Australia/Adelaide":             true,
+	"Asia/Vladivostok":               true,
+	"Pacific/Port_Moresby":           true,
+	"Australia/Brisbane":             true,
+	"Australia/Sydney":               true,
+	"Australia/Hobart":               true,
+	"Asia/Magadan":                   true,
+	"SST":                            true,
+	"Pacific/Noumea":                 true,
+	"Asia/Kamchatka":                 true,
+	"Pacific/Fiji":                   true,
+	"Pacific/Auckland":               true,
+	"Pacific/Apia":                   true,
+}
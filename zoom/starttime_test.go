@@ -0,0 +1,92 @@
+package zoom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartTime_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	loc, _ := time.LoadLocation("America/New_York")
+	start := time.Date(2024, 3, 11, 9, 30, 15, 0, loc)
+
+	b, err := json.Marshal(NewStartTime(start))
+	assert.NoError(err)
+	assert.Equal(`"2024-03-11T13:30:15Z"`, string(b))
+
+	st, err := NewLocalStartTime(start.UTC(), "America/New_York")
+	assert.NoError(err)
+
+	b, err = json.Marshal(st)
+	assert.NoError(err)
+	assert.Equal(`"2024-03-11T09:30:15"`, string(b))
+
+	b, err = json.Marshal(&MeetingsCreateOptions{StartTime: st, Timezone: Ptr(st.Timezone)})
+	assert.NoError(err)
+	assert.JSONEq(`{"start_time":"2024-03-11T09:30:15","timezone":"America/New_York"}`, string(b))
+
+	b, err = json.Marshal(&WebinarsCreateOptions{StartTime: st})
+	assert.NoError(err)
+	assert.JSONEq(`{"start_time":"2024-03-11T09:30:15","timezone":"America/New_York"}`, string(b))
+
+	_, err = json.Marshal(&MeetingsUpdateOptions{MeetingsCreateOptions: &MeetingsCreateOptions{StartTime: st, Timezone: Ptr("Europe/Berlin")}})
+	assert.ErrorContains(err, "does not match")
+
+	b, err = json.Marshal(&MeetingsUpdateOptions{})
+	assert.NoError(err)
+	assert.Equal(`{}`, string(b))
+
+	_, err = json.Marshal(&StartTime{Time: start, Timezone: "America/Toronto"})
+	assert.ErrorIs(err, ErrUnsupportedTimezone)
+
+	_, err = NewLocalStartTime(start, "Mars/Olympus")
+	assert.ErrorIs(err, ErrUnsupportedTimezone)
+}
+
+func TestStartTime_UnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	for s, expected := range map[string]time.Time{
+		`"2024-03-11T13:30:00Z"`:      time.Date(2024, 3, 11, 13, 30, 0, 0, time.UTC),
+		`"2024-03-11T09:30:00-04:00"`: time.Date(2024, 3, 11, 13, 30, 0, 0, time.UTC),
+		`"2024-03-11T13:30:00"`:       time.Date(2024, 3, 11, 13, 30, 0, 0, time.UTC),
+		`"2024-03-11T13:30"`:          time.Date(2024, 3, 11, 13, 30, 0, 0, time.UTC),
+		`""`:                          {},
+	} {
+		st := StartTime{}
+		err := json.Unmarshal([]byte(s), &st)
+		assert.NoError(err, s)
+		assert.True(expected.Equal(st.Time), s)
+	}
+
+	st := StartTime{Timezone: "Europe/Berlin"}
+	err := json.Unmarshal([]byte(`"2024-07-01T10:00:00"`), &st)
+	assert.NoError(err)
+	assert.Equal(time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC), st.UTC())
+
+	out := &MeetingsCreateResponse{}
+	err = json.Unmarshal([]byte(`{"start_time":"","type":3}`), out)
+	assert.NoError(err)
+	assert.True(out.StartTime.IsZero())
+
+	err = json.Unmarshal([]byte(`"next tuesday"`), &st)
+	assert.Error(err)
+}
+
+func TestValidateTimezone(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(ValidateTimezone("Asia/Kolkata"))
+	assert.NoError(ValidateTimezone("UTC"))
+	assert.ErrorIs(ValidateTimezone("Local"), ErrUnsupportedTimezone)
+	assert.ErrorIs(ValidateTimezone(""), ErrUnsupportedTimezone)
+
+	for timezone := range timezones {
+		_, err := LoadTimezone(timezone)
+		assert.NoError(err, timezone)
+	}
+}
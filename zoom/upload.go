@@ -0,0 +1,68 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// fileBaseURL is the base URL of Zoom's API for uploading files.
+const fileBaseURL = "https://file.zoom.us/v2"
+
+// upload sends r to uploadURL as the fileField file of a multipart/form-data request along with fields, streaming it
+// without buffering it in memory, and decodes the JSON response into out. The request is authenticated the same way as
+// the client's API requests.
+func (c *Client) upload(ctx context.Context, uploadURL, fileField, fileName string, r io.Reader, fields map[string]string, out any) (*http.Response, error) {
+	token, err := c.auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		pw.CloseWithError(writeMultipart(mw, fileField, fileName, r, fields))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, pr)
+	if err != nil {
+		pr.Close()
+		return nil, fmt.Errorf("making new HTTP request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		pr.Close()
+		return nil, fmt.Errorf("doing HTTP request: %w", err)
+	}
+	defer res.Body.Close()
+
+	return res, c.decodeResponse(ctx, res, out)
+}
+
+func writeMultipart(mw *multipart.Writer, fileField, fileName string, r io.Reader, fields map[string]string) error {
+	for name, value := range fields {
+		err := mw.WriteField(name, value)
+		if err != nil {
+			return fmt.Errorf("writing field %s: %w", name, err)
+		}
+	}
+
+	part, err := mw.CreateFormFile(fileField, fileName)
+	if err != nil {
+		return fmt.Errorf("creating file part: %w", err)
+	}
+
+	_, err = io.Copy(part, r)
+	if err != nil {
+		return fmt.Errorf("copying file: %w", err)
+	}
+
+	return mw.Close()
+}
@@ -0,0 +1,67 @@
+package zoom
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_upload(t *testing.T) {
+	assert := assert.New(t)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPost, r.Method)
+		assert.Equal("Bearer token", r.Header.Get("Authorization"))
+
+		err := r.ParseMultipartForm(1 << 20)
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal("channel-id", r.FormValue("to_channel"))
+
+		f, header, err := r.FormFile("files")
+		if !assert.NoError(err) {
+			return
+		}
+		defer f.Close()
+
+		b, _ := io.ReadAll(f)
+		assert.Equal("summary.txt", header.Filename)
+		assert.Equal("file contents", string(b))
+
+		w.Write([]byte(`{"id":"file-id"}`))
+	}))
+	defer s.Close()
+
+	out := &struct {
+		ID string `json:"id"`
+	}{}
+
+	_, err := newDownloadTestClient(t).upload(context.Background(), s.URL, "files", "summary.txt", strings.NewReader("file contents"), map[string]string{
+		"to_channel": "channel-id",
+	}, out)
+
+	assert.NoError(err)
+	assert.Equal("file-id", out.ID)
+}
+
+func TestClient_upload_Error(t *testing.T) {
+	assert := assert.New(t)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":300,"message":"Invalid file"}`))
+	}))
+	defer s.Close()
+
+	res, err := newDownloadTestClient(t).upload(context.Background(), s.URL, "file", "a.bin", strings.NewReader("x"), nil, nil)
+
+	assert.ErrorContains(err, "Invalid file")
+	assert.Equal(http.StatusBadRequest, res.StatusCode)
+}
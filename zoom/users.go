@@ -13,6 +13,9 @@ const (
 	ActionAutoCreate UsersCreateAction = "autoCreate"
 	ActionCustCreate UsersCreateAction = "custCreate"
 	ActionSSOCreate  UsersCreateAction = "ssoCreate"
+
+	UsersStatusActionActivate   UsersStatusAction = "activate"
+	UsersStatusActionDeactivate UsersStatusAction = "deactivate"
 )
 
 type UsersCreateAction string
@@ -21,10 +24,30 @@ func (u UsersCreateAction) String() string {
 	return string(u)
 }
 
+type UsersStatusAction string
+
+func (u UsersStatusAction) String() string {
+	return string(u)
+}
+
 type UsersServicer interface {
 	List(ctx context.Context, opts *UsersListOptions) (*UsersListResponse, *http.Response, error)
+	Get(ctx context.Context, userID string, opts *UsersGetOptions) (*UsersGetResponse, *http.Response, error)
 	Create(ctx context.Context, opts *UsersCreateOptions) (*UsersCreateResponse, *http.Response, error)
+	Update(ctx context.Context, userID string, opts *UsersUpdateOptions) (*http.Response, error)
+	UpdateStatus(ctx context.Context, userID string, opts *UsersUpdateStatusOptions) (*http.Response, error)
 	Delete(ctx context.Context, userID string, opts *UsersDeleteOptions) (*http.Response, error)
+
+	ListAssistants(ctx context.Context, userID string) (*UsersListAssistantsResponse, *http.Response, error)
+	AddAssistants(ctx context.Context, userID string, opts *UsersAddAssistantsOptions) (*UsersAddAssistantsResponse, *http.Response, error)
+	DeleteAssistant(ctx context.Context, userID string, assistantID string) (*http.Response, error)
+	DeleteAllAssistants(ctx context.Context, userID string) (*http.Response, error)
+	ListSchedulers(ctx context.Context, userID string) (*UsersListSchedulersResponse, *http.Response, error)
+	DeleteScheduler(ctx context.Context, userID string, schedulerID string) (*http.Response, error)
+	DeleteAllSchedulers(ctx context.Context, userID string) (*http.Response, error)
+
+	Token(ctx context.Context, userID string, tokenType UsersTokenType, opts *UsersTokenOptions) (*UsersTokenResponse, *http.Response, error)
+	MeetingSDKStart(ctx context.Context, hostID string, claims *MeetingSDKClaims, sdkKey, sdkSecret string) (*MeetingSDKStart, error)
 }
 
 type UsersService struct {
@@ -87,6 +110,34 @@ func (u *UsersService) List(ctx context.Context, opts *UsersListOptions) (*Users
 	return out, res, nil
 }
 
+type UsersGetOptions struct {
+	EncryptedEmail *bool `url:"encrypted_email,omitempty"`
+	LoginType      *int  `url:"login_type,omitempty"`
+}
+
+type UsersGetResponse struct {
+	UsersListItem
+
+	AccountID   string `json:"account_id"`
+	JobTitle    string `json:"job_title"`
+	Language    string `json:"language"`
+	Location    string `json:"location"`
+	PhoneNumber string `json:"phone_number"`
+	PicURL      string `json:"pic_url"`
+}
+
+// Get returns a user by ID or email address.
+func (u *UsersService) Get(ctx context.Context, userID string, opts *UsersGetOptions) (*UsersGetResponse, *http.Response, error) {
+	out := &UsersGetResponse{}
+
+	res, err := u.client.request(ctx, http.MethodGet, "/users/"+url.QueryEscape(userID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
 type UsersCreateOptions struct {
 	Action   UsersCreateAction           `json:"action"`
 	UserInfo *UsersCreateOptionsUserInfo `json:"user_info"`
@@ -120,6 +171,41 @@ func (u *UsersService) Create(ctx context.Context, opts *UsersCreateOptions) (*U
 	return out, res, nil
 }
 
+type UsersUpdateOptions struct {
+	Dept        *string `json:"dept,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	FirstName   *string `json:"first_name,omitempty"`
+	JobTitle    *string `json:"job_title,omitempty"`
+	Language    *string `json:"language,omitempty"`
+	LastName    *string `json:"last_name,omitempty"`
+	Location    *string `json:"location,omitempty"`
+	Timezone    *string `json:"timezone,omitempty"`
+	Type        *int    `json:"type,omitempty"`
+}
+
+func (u *UsersService) Update(ctx context.Context, userID string, opts *UsersUpdateOptions) (*http.Response, error) {
+	res, err := u.client.request(ctx, http.MethodPatch, "/users/"+url.QueryEscape(userID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type UsersUpdateStatusOptions struct {
+	Action UsersStatusAction `json:"action"`
+}
+
+// UpdateStatus activates or deactivates a user. Deactivated users cannot sign in but keep their data.
+func (u *UsersService) UpdateStatus(ctx context.Context, userID string, opts *UsersUpdateStatusOptions) (*http.Response, error) {
+	res, err := u.client.request(ctx, http.MethodPut, "/users/"+url.QueryEscape(userID)+"/status", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
 type UsersDeleteOptions struct {
 	Action *string `url:"action,omitempty"`
 }
@@ -0,0 +1,109 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type UsersListAssistantsResponse struct {
+	Assistants []*UsersAssistant `json:"assistants"`
+}
+
+type UsersAssistant struct {
+	Email string `json:"email"`
+	ID    string `json:"id"`
+}
+
+// ListAssistants lists the users who can schedule meetings on behalf of userID.
+func (u *UsersService) ListAssistants(ctx context.Context, userID string) (*UsersListAssistantsResponse, *http.Response, error) {
+	out := &UsersListAssistantsResponse{}
+
+	res, err := u.client.request(ctx, http.MethodGet, "/users/"+url.QueryEscape(userID)+"/assistants", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type UsersAddAssistantsOptions struct {
+	Assistants []*UserIdentifier `json:"assistants"`
+}
+
+type UsersAddAssistantsResponse struct {
+	AddAt time.Time `json:"add_at"`
+	IDs   string    `json:"ids"`
+}
+
+// AddAssistants allows users to schedule meetings on behalf of userID. Assistants must belong to the same account.
+func (u *UsersService) AddAssistants(ctx context.Context, userID string, opts *UsersAddAssistantsOptions) (*UsersAddAssistantsResponse, *http.Response, error) {
+	out := &UsersAddAssistantsResponse{}
+
+	res, err := u.client.request(ctx, http.MethodPost, "/users/"+url.QueryEscape(userID)+"/assistants", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (u *UsersService) DeleteAssistant(ctx context.Context, userID string, assistantID string) (*http.Response, error) {
+	res, err := u.client.request(ctx, http.MethodDelete, "/users/"+url.QueryEscape(userID)+"/assistants/"+url.QueryEscape(assistantID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (u *UsersService) DeleteAllAssistants(ctx context.Context, userID string) (*http.Response, error) {
+	res, err := u.client.request(ctx, http.MethodDelete, "/users/"+url.QueryEscape(userID)+"/assistants", nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type UsersListSchedulersResponse struct {
+	Schedulers []*UsersScheduler `json:"schedulers"`
+}
+
+type UsersScheduler struct {
+	Email string `json:"email"`
+	ID    string `json:"id"`
+	PMI   int64  `json:"pmi"`
+}
+
+// ListSchedulers lists the users that userID can schedule meetings on behalf of.
+func (u *UsersService) ListSchedulers(ctx context.Context, userID string) (*UsersListSchedulersResponse, *http.Response, error) {
+	out := &UsersListSchedulersResponse{}
+
+	res, err := u.client.request(ctx, http.MethodGet, "/users/"+url.QueryEscape(userID)+"/schedulers", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (u *UsersService) DeleteScheduler(ctx context.Context, userID string, schedulerID string) (*http.Response, error) {
+	res, err := u.client.request(ctx, http.MethodDelete, "/users/"+url.QueryEscape(userID)+"/schedulers/"+url.QueryEscape(schedulerID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (u *UsersService) DeleteAllSchedulers(ctx context.Context, userID string) (*http.Response, error) {
+	res, err := u.client.request(ctx, http.MethodDelete, "/users/"+url.QueryEscape(userID)+"/schedulers", nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
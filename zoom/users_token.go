@@ -0,0 +1,98 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// UsersTokenTypeZAK is the Zoom Access Key used to start or join meetings on behalf of the user.
+	UsersTokenTypeZAK   UsersTokenType = "zak"
+	UsersTokenTypeToken UsersTokenType = "token"
+)
+
+// UsersTokenType is the type of token to get for a user.
+type UsersTokenType string
+
+func (u UsersTokenType) String() string {
+	return string(u)
+}
+
+type UsersTokenOptions struct {
+	// TTL is how many seconds a ZAK is valid for, up to a year. Zoom defaults to 2 hours.
+	TTL *int `url:"ttl,omitempty"`
+}
+
+type usersTokenQuery struct {
+	*UsersTokenOptions `url:",omitempty"`
+
+	Type UsersTokenType `url:"type"`
+}
+
+type UsersTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// Token gets a token of the given type for a user. Use UsersTokenTypeZAK to start a meeting as the user with a Meeting
+// SDK.
+func (u *UsersService) Token(ctx context.Context, userID string, tokenType UsersTokenType, opts *UsersTokenOptions) (*UsersTokenResponse, *http.Response, error) {
+	out := &UsersTokenResponse{}
+
+	query := &usersTokenQuery{
+		UsersTokenOptions: opts,
+		Type:              tokenType,
+	}
+
+	res, err := u.client.request(ctx, http.MethodGet, "/users/"+url.QueryEscape(userID)+"/token", query, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// MeetingSDKStart is what a Meeting SDK client needs to start a meeting as its host. It is named after the
+// parameters the web Meeting SDK joins with.
+type MeetingSDKStart struct {
+	MeetingNumber int64  `json:"meetingNumber"`
+	SDKKey        string `json:"sdkKey"`
+	Signature     string `json:"signature"`
+	ZAK           string `json:"zak"`
+}
+
+// MeetingSDKStart gets the ZAK of the meeting's host and signs a Meeting SDK JWT for claims with the host role. The ZAK
+// is valid for as long as the JWT.
+func (u *UsersService) MeetingSDKStart(ctx context.Context, hostID string, claims *MeetingSDKClaims, sdkKey, sdkSecret string) (*MeetingSDKStart, error) {
+	if claims == nil {
+		return nil, fmt.Errorf("%w: missing claims", ErrInvalidSDKClaims)
+	}
+
+	hostClaims := *claims
+	hostClaims.Role = SDKRoleHost
+
+	signature, err := hostClaims.Sign(sdkKey, sdkSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration := hostClaims.Expiration
+	if expiration == 0 {
+		expiration = SDKJWTDefaultExpiration
+	}
+
+	zak, _, err := u.Token(ctx, hostID, UsersTokenTypeZAK, &UsersTokenOptions{
+		TTL: Ptr(int(expiration.Seconds())),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting ZAK: %w", err)
+	}
+
+	return &MeetingSDKStart{
+		MeetingNumber: hostClaims.MeetingNumber,
+		SDKKey:        sdkKey,
+		Signature:     signature,
+		ZAK:           zak.Token,
+	}, nil
+}
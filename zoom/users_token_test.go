@@ -0,0 +1,56 @@
+package zoom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersService_MeetingSDKStart(t *testing.T) {
+	assert := assert.New(t)
+
+	var query url.Values
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/v2/users/host@example.com/token", r.URL.Path)
+		query = r.URL.Query()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"zak-token"}`))
+	}))
+	defer s.Close()
+
+	target, err := url.Parse(s.URL)
+	assert.NoError(err)
+
+	client := newDownloadTestClient(t)
+	client.httpClient = &http.Client{Transport: &rewriteTransport{target: target}}
+
+	start, err := client.Users.MeetingSDKStart(context.Background(), "host@example.com", &MeetingSDKClaims{
+		MeetingNumber: 123,
+		Role:          SDKRoleParticipant,
+	}, "key", "secret")
+	assert.NoError(err)
+	assert.Equal("zak", query.Get("type"))
+	assert.Equal("86400", query.Get("ttl"))
+	assert.Equal(&MeetingSDKStart{MeetingNumber: 123, SDKKey: "key", Signature: start.Signature, ZAK: "zak-token"}, start)
+
+	claims, err := VerifyMeetingSDKJWT(start.Signature, "key", "secret")
+	assert.NoError(err)
+	assert.Equal(SDKRoleHost, claims.Role)
+	assert.Equal(int64(123), claims.MeetingNumber)
+
+	_, err = client.Users.MeetingSDKStart(context.Background(), "host@example.com", &MeetingSDKClaims{
+		MeetingNumber: 123,
+		Expiration:    2 * time.Hour,
+	}, "key", "secret")
+	assert.NoError(err)
+	assert.Equal("7200", query.Get("ttl"))
+
+	_, err = client.Users.MeetingSDKStart(context.Background(), "host@example.com", nil, "key", "secret")
+	assert.ErrorIs(err, ErrInvalidSDKClaims)
+}
@@ -0,0 +1,429 @@
+package zoom
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	VideoSDKSessionTypeLive VideoSDKSessionType = "live"
+	VideoSDKSessionTypePast VideoSDKSessionType = "past"
+
+	VideoSDKSessionEventRecordingStart  VideoSDKSessionEvent = "recording.start"
+	VideoSDKSessionEventRecordingStop   VideoSDKSessionEvent = "recording.stop"
+	VideoSDKSessionEventRecordingPause  VideoSDKSessionEvent = "recording.pause"
+	VideoSDKSessionEventRecordingResume VideoSDKSessionEvent = "recording.resume"
+	VideoSDKSessionEventUserInvite      VideoSDKSessionEvent = "user.invite.callout"
+
+	// videoSDKMaxRangeDays is the longest from/to range accepted when listing sessions or recordings.
+	videoSDKMaxRangeDays = 30
+	// videoSDKTokenExpiration is how long the JWTs signed for each Video SDK API request are valid.
+	videoSDKTokenExpiration = 5 * time.Minute
+)
+
+// VideoSDKSessionType selects live or past sessions.
+type VideoSDKSessionType string
+
+func (v VideoSDKSessionType) String() string {
+	return string(v)
+}
+
+// VideoSDKSessionEvent is an action taken in a live session.
+type VideoSDKSessionEvent string
+
+func (v VideoSDKSessionEvent) String() string {
+	return string(v)
+}
+
+type VideoSDKServicer interface {
+	ListSessions(ctx context.Context, opts *VideoSDKListSessionsOptions) (*VideoSDKListSessionsResponse, *http.Response, error)
+	ListSessionsRange(ctx context.Context, from, to time.Time, opts *VideoSDKListSessionsOptions) ([]*VideoSDKSession, error)
+	GetSession(ctx context.Context, sessionID string, opts *VideoSDKGetSessionOptions) (*VideoSDKSession, *http.Response, error)
+	ListSessionUsers(ctx context.Context, sessionID string, opts *VideoSDKListSessionUsersOptions) (*VideoSDKListSessionUsersResponse, *http.Response, error)
+	ListSessionUsersQoS(ctx context.Context, sessionID string, opts *VideoSDKListSessionUsersOptions) (*VideoSDKListSessionUsersQoSResponse, *http.Response, error)
+	GetSessionUserQoS(ctx context.Context, sessionID string, userID string, opts *VideoSDKGetSessionOptions) (*VideoSDKSessionUserQoS, *http.Response, error)
+
+	EndSession(ctx context.Context, sessionID string) (*http.Response, error)
+	UpdateSessionEvent(ctx context.Context, sessionID string, opts *VideoSDKUpdateSessionEventOptions) (*http.Response, error)
+
+	ListRecordings(ctx context.Context, opts *VideoSDKListRecordingsOptions) (*VideoSDKListRecordingsResponse, *http.Response, error)
+	ListRecordingsRange(ctx context.Context, from, to time.Time, opts *VideoSDKListRecordingsOptions) ([]*VideoSDKSessionRecordings, error)
+	GetSessionRecordings(ctx context.Context, sessionID string) (*VideoSDKSessionRecordings, *http.Response, error)
+	DeleteSessionRecordings(ctx context.Context, sessionID string, opts *RecordingsDeleteOptions) (*http.Response, error)
+	DeleteSessionRecording(ctx context.Context, sessionID string, recordingID string, opts *RecordingsDeleteOptions) (*http.Response, error)
+	DownloadRecording(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error)
+}
+
+// VideoSDKService covers the Video SDK API, which is authenticated with JWTs signed with a Video SDK API key and secret
+// instead of Server-to-Server OAuth. Use NewVideoSDKClient to create a client for it.
+type VideoSDKService struct {
+	client *Client
+}
+
+var _ VideoSDKServicer = (*VideoSDKService)(nil)
+
+// NewVideoSDKClient returns a client for the Video SDK API (see https://developers.zoom.us/docs/video-sdk/api/). Only
+// its VideoSDK service is set.
+func NewVideoSDKClient(httpClient *http.Client, apiKey, apiSecret string) *Client {
+	c := &Client{
+		httpClient: httpClient,
+		auth: func(ctx context.Context) (string, error) {
+			return videoSDKToken(apiKey, apiSecret, time.Now())
+		},
+	}
+
+	c.VideoSDK = &VideoSDKService{c}
+
+	return c
+}
+
+// videoSDKToken signs a short-lived Video SDK API JWT.
+func videoSDKToken(apiKey, apiSecret string, now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": apiKey,
+		"iat": now.Unix(),
+		"exp": now.Add(videoSDKTokenExpiration).Unix(),
+	})
+
+	tokenStr, err := token.SignedString([]byte(apiSecret))
+	if err != nil {
+		return "", fmt.Errorf("signing JWT: %w", err)
+	}
+
+	return tokenStr, nil
+}
+
+func videoSDKSessionPath(sessionID string) string {
+	return "/videosdk/sessions/" + escapeUUID(sessionID)
+}
+
+type VideoSDKListSessionsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From       *time.Time           `url:"from,omitempty" layout:"2006-01-02"`
+	SessionKey *string              `url:"session_key,omitempty"`
+	To         *time.Time           `url:"to,omitempty" layout:"2006-01-02"`
+	Type       *VideoSDKSessionType `url:"type,omitempty"`
+}
+
+type VideoSDKListSessionsResponse struct {
+	*PaginationResponse
+
+	From     string             `json:"from"`
+	Sessions []*VideoSDKSession `json:"sessions"`
+	To       string             `json:"to"`
+}
+
+type VideoSDKSession struct {
+	Duration       string    `json:"duration"`
+	EndTime        time.Time `json:"end_time"`
+	HasPSTN        bool      `json:"has_pstn"`
+	HasRecording   bool      `json:"has_recording"`
+	HasScreenShare bool      `json:"has_screen_share"`
+	HasVideo       bool      `json:"has_video"`
+	HasVoIP        bool      `json:"has_voip"`
+	ID             string    `json:"id"`
+	RecordingSize  int64     `json:"recording_size"`
+	SessionKey     string    `json:"session_key"`
+	SessionName    string    `json:"session_name"`
+	StartTime      time.Time `json:"start_time"`
+	UserCount      int       `json:"user_count"`
+}
+
+func (v *VideoSDKService) ListSessions(ctx context.Context, opts *VideoSDKListSessionsOptions) (*VideoSDKListSessionsResponse, *http.Response, error) {
+	out := &VideoSDKListSessionsResponse{}
+
+	res, err := v.client.request(ctx, http.MethodGet, "/videosdk/sessions", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListSessionsRange lists every session between from and to (inclusive). The range is split into Zoom's maximum window
+// and each window is paginated through, so any From, To and NextPageToken set on opts are ignored.
+func (v *VideoSDKService) ListSessionsRange(ctx context.Context, from, to time.Time, opts *VideoSDKListSessionsOptions) ([]*VideoSDKSession, error) {
+	rangeOpts := &VideoSDKListSessionsOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	sessions, err := listAllInRange(ctx, from, to, videoSDKMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*VideoSDKSession, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := v.ListSessions(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Sessions, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+type VideoSDKGetSessionOptions struct {
+	Type *VideoSDKSessionType `url:"type,omitempty"`
+}
+
+// GetSession returns a live or past session. sessionID is the session's UUID.
+func (v *VideoSDKService) GetSession(ctx context.Context, sessionID string, opts *VideoSDKGetSessionOptions) (*VideoSDKSession, *http.Response, error) {
+	out := &VideoSDKSession{}
+
+	res, err := v.client.request(ctx, http.MethodGet, videoSDKSessionPath(sessionID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type VideoSDKListSessionUsersOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	Type *VideoSDKSessionType `url:"type,omitempty"`
+}
+
+type VideoSDKListSessionUsersResponse struct {
+	*PaginationResponse
+
+	Users []*VideoSDKSessionUser `json:"users"`
+}
+
+type VideoSDKSessionUser struct {
+	Camera          string    `json:"camera"`
+	ConnectionType  string    `json:"connection_type"`
+	DataCenter      string    `json:"data_center"`
+	Device          string    `json:"device"`
+	Domain          string    `json:"domain"`
+	HarddiskID      string    `json:"harddisk_id"`
+	ID              string    `json:"id"`
+	IPAddress       string    `json:"ip_address"`
+	JoinTime        time.Time `json:"join_time"`
+	LeaveReason     string    `json:"leave_reason"`
+	LeaveTime       time.Time `json:"leave_time"`
+	Location        string    `json:"location"`
+	MacAddr         string    `json:"mac_addr"`
+	Microphone      string    `json:"microphone"`
+	Name            string    `json:"name"`
+	NetworkType     string    `json:"network_type"`
+	ParticipantUUID string    `json:"participant_uuid"`
+	PCName          string    `json:"pc_name"`
+	Role            string    `json:"role"`
+	Speaker         string    `json:"speaker"`
+	UserKey         string    `json:"user_key"`
+	Version         string    `json:"version"`
+}
+
+func (v *VideoSDKService) ListSessionUsers(ctx context.Context, sessionID string, opts *VideoSDKListSessionUsersOptions) (*VideoSDKListSessionUsersResponse, *http.Response, error) {
+	out := &VideoSDKListSessionUsersResponse{}
+
+	res, err := v.client.request(ctx, http.MethodGet, videoSDKSessionPath(sessionID)+"/users", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type VideoSDKListSessionUsersQoSResponse struct {
+	*PaginationResponse
+
+	Users []*VideoSDKSessionUserQoS `json:"users"`
+}
+
+// VideoSDKSessionUserQoS holds the quality of a session user's streams, sampled in the same intervals as the Dashboard's
+// participant QoS.
+type VideoSDKSessionUserQoS struct {
+	Device     string                  `json:"device"`
+	Domain     string                  `json:"domain"`
+	HarddiskID string                  `json:"harddisk_id"`
+	ID         string                  `json:"id"`
+	IPAddress  string                  `json:"ip_address"`
+	JoinTime   time.Time               `json:"join_time"`
+	LeaveTime  time.Time               `json:"leave_time"`
+	Location   string                  `json:"location"`
+	MacAddr    string                  `json:"mac_addr"`
+	Name       string                  `json:"name"`
+	PCName     string                  `json:"pc_name"`
+	UserKey    string                  `json:"user_key"`
+	UserQoS    []*DashboardQoSInterval `json:"user_qos"`
+	Version    string                  `json:"version"`
+}
+
+func (v *VideoSDKService) ListSessionUsersQoS(ctx context.Context, sessionID string, opts *VideoSDKListSessionUsersOptions) (*VideoSDKListSessionUsersQoSResponse, *http.Response, error) {
+	out := &VideoSDKListSessionUsersQoSResponse{}
+
+	res, err := v.client.request(ctx, http.MethodGet, videoSDKSessionPath(sessionID)+"/users/qos", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (v *VideoSDKService) GetSessionUserQoS(ctx context.Context, sessionID string, userID string, opts *VideoSDKGetSessionOptions) (*VideoSDKSessionUserQoS, *http.Response, error) {
+	out := &VideoSDKSessionUserQoS{}
+
+	res, err := v.client.request(ctx, http.MethodGet, videoSDKSessionPath(sessionID)+"/users/"+url.QueryEscape(userID)+"/qos", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type videoSDKSessionStatusBody struct {
+	Action string `json:"action"`
+}
+
+// EndSession ends a live session for every user in it.
+func (v *VideoSDKService) EndSession(ctx context.Context, sessionID string) (*http.Response, error) {
+	res, err := v.client.request(ctx, http.MethodPut, videoSDKSessionPath(sessionID)+"/status", nil, &videoSDKSessionStatusBody{Action: "end"}, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type VideoSDKUpdateSessionEventOptions struct {
+	Method VideoSDKSessionEvent                     `json:"method"`
+	Params *VideoSDKUpdateSessionEventOptionsParams `json:"params,omitempty"`
+}
+
+// VideoSDKUpdateSessionEventOptionsParams are required to invite a phone user into a session.
+type VideoSDKUpdateSessionEventOptionsParams struct {
+	InviteeName   *string                                         `json:"invitee_name,omitempty"`
+	InviteOptions *VideoSDKUpdateSessionEventOptionsInviteOptions `json:"invite_options,omitempty"`
+	PhoneNumber   *string                                         `json:"phone_number,omitempty"`
+}
+
+type VideoSDKUpdateSessionEventOptionsInviteOptions struct {
+	GreetingPrompt     *bool `json:"greeting_prompt,omitempty"`
+	RequirePressingOne *bool `json:"require_pressing_one,omitempty"`
+}
+
+// UpdateSessionEvent controls a live session, starting, stopping, pausing or resuming its cloud recording or calling out
+// to a phone user.
+func (v *VideoSDKService) UpdateSessionEvent(ctx context.Context, sessionID string, opts *VideoSDKUpdateSessionEventOptions) (*http.Response, error) {
+	res, err := v.client.request(ctx, http.MethodPatch, videoSDKSessionPath(sessionID)+"/events", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+type VideoSDKListRecordingsOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	From      *time.Time `url:"from,omitempty" layout:"2006-01-02"`
+	To        *time.Time `url:"to,omitempty" layout:"2006-01-02"`
+	Trash     *bool      `url:"trash,omitempty"`
+	TrashType *string    `url:"trash_type,omitempty"`
+}
+
+type VideoSDKListRecordingsResponse struct {
+	*PaginationResponse
+
+	From     string                       `json:"from"`
+	Sessions []*VideoSDKSessionRecordings `json:"sessions"`
+	To       string                       `json:"to"`
+}
+
+type VideoSDKSessionRecordings struct {
+	Duration       int              `json:"duration"`
+	RecordingCount int              `json:"recording_count"`
+	RecordingFiles []*RecordingFile `json:"recording_files"`
+	SessionID      string           `json:"session_id"`
+	SessionKey     string           `json:"session_key"`
+	SessionName    string           `json:"session_name"`
+	StartTime      time.Time        `json:"start_time"`
+	TotalSize      int64            `json:"total_size"`
+}
+
+func (v *VideoSDKService) ListRecordings(ctx context.Context, opts *VideoSDKListRecordingsOptions) (*VideoSDKListRecordingsResponse, *http.Response, error) {
+	out := &VideoSDKListRecordingsResponse{}
+
+	res, err := v.client.request(ctx, http.MethodGet, "/videosdk/recordings", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// ListRecordingsRange lists the recordings of every session between from and to (inclusive). The range is split into
+// Zoom's maximum window and each window is paginated through, so any From, To and NextPageToken set on opts are ignored.
+func (v *VideoSDKService) ListRecordingsRange(ctx context.Context, from, to time.Time, opts *VideoSDKListRecordingsOptions) ([]*VideoSDKSessionRecordings, error) {
+	rangeOpts := &VideoSDKListRecordingsOptions{}
+	if opts != nil {
+		*rangeOpts = *opts
+	}
+
+	sessions, err := listAllInRange(ctx, from, to, videoSDKMaxRangeDays, rangeOpts.PaginationOptions, func(dr DateRange, page *PaginationOptions) ([]*VideoSDKSessionRecordings, *PaginationResponse, error) {
+		rangeOpts.From = Ptr(dr.From)
+		rangeOpts.To = Ptr(dr.To)
+		rangeOpts.PaginationOptions = page
+
+		out, _, err := v.ListRecordings(ctx, rangeOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return out.Sessions, out.PaginationResponse, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing recordings: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (v *VideoSDKService) GetSessionRecordings(ctx context.Context, sessionID string) (*VideoSDKSessionRecordings, *http.Response, error) {
+	out := &VideoSDKSessionRecordings{}
+
+	res, err := v.client.request(ctx, http.MethodGet, videoSDKSessionPath(sessionID)+"/recordings", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (v *VideoSDKService) DeleteSessionRecordings(ctx context.Context, sessionID string, opts *RecordingsDeleteOptions) (*http.Response, error) {
+	res, err := v.client.request(ctx, http.MethodDelete, videoSDKSessionPath(sessionID)+"/recordings", opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (v *VideoSDKService) DeleteSessionRecording(ctx context.Context, sessionID string, recordingID string, opts *RecordingsDeleteOptions) (*http.Response, error) {
+	res, err := v.client.request(ctx, http.MethodDelete, videoSDKSessionPath(sessionID)+"/recordings/"+url.QueryEscape(recordingID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making request: %w", err)
+	}
+
+	return res, nil
+}
+
+// DownloadRecording streams a session recording file from its download URL into w. See DownloadOptions for resuming and
+// verifying downloads.
+func (v *VideoSDKService) DownloadRecording(ctx context.Context, downloadURL string, w io.Writer, opts *DownloadOptions) (int64, *http.Response, error) {
+	return v.client.download(ctx, downloadURL, w, opts)
+}
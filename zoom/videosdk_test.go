@@ -0,0 +1,76 @@
+package zoom
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideoSDKService_DownloadRecording(t *testing.T) {
+	assert := assert.New(t)
+
+	content := []byte("session recording")
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenStr := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+		token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
+			return []byte("secret"), nil
+		})
+		if !assert.NoError(err) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		assert.Equal("key", token.Claims.(jwt.MapClaims)["iss"])
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}))
+	defer s.Close()
+
+	client := NewVideoSDKClient(&http.Client{}, "key", "secret")
+	buf := &bytes.Buffer{}
+
+	n, _, err := client.VideoSDK.DownloadRecording(context.Background(), s.URL, buf, nil)
+
+	assert.NoError(err)
+	assert.Equal(int64(len(content)), n)
+	assert.Equal(content, buf.Bytes())
+}
+
+func TestVideoSDKService_DownloadRecording_Unauthorized(t *testing.T) {
+	assert := assert.New(t)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer s.Close()
+
+	// A Video SDK client has no token mutex to clear when Zoom rejects its JWT.
+	_, res, err := NewVideoSDKClient(&http.Client{}, "key", "secret").VideoSDK.DownloadRecording(context.Background(), s.URL, &bytes.Buffer{}, nil)
+
+	assert.ErrorContains(err, "received non-200 status code: 401")
+	assert.Equal(http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestVideoSDKToken(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+
+	tokenStr, err := videoSDKToken("key", "secret", now)
+	assert.NoError(err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	})
+	assert.NoError(err)
+	assert.Equal("key", claims["iss"])
+	assert.Equal(float64(now.Add(videoSDKTokenExpiration).Unix()), claims["exp"])
+}
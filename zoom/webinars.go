@@ -0,0 +1,573 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	WebinarTypeWebinar        WebinarType = 5
+	WebinarTypeRecurring      WebinarType = 6
+	WebinarTypeRecurringFixed WebinarType = 9
+)
+
+type WebinarType int
+
+func (w WebinarType) Int() int {
+	return int(w)
+}
+
+type WebinarsServicer interface {
+	List(ctx context.Context, userID string, opts *WebinarsListOptions) (*WebinarsListResponse, *http.Response, error)
+	Get(ctx context.Context, webinarID int64, opts *WebinarsGetOptions) (*Webinar, *http.Response, error)
+	Create(ctx context.Context, userID string, opts *WebinarsCreateOptions) (*Webinar, *http.Response, error)
+	Update(ctx context.Context, webinarID int64, opts *WebinarsUpdateOptions) (*http.Response, error)
+	Delete(ctx context.Context, webinarID int64, opts *WebinarsDeleteOptions) (*http.Response, error)
+	UpdateStatus(ctx context.Context, webinarID int64, opts *WebinarsUpdateStatusOptions) (*http.Response, error)
+
+	ListPanelists(ctx context.Context, webinarID int64) (*WebinarsListPanelistsResponse, *http.Response, error)
+	AddPanelists(ctx context.Context, webinarID int64, opts *WebinarsAddPanelistsOptions) (*WebinarsAddPanelistsResponse, *http.Response, error)
+	RemovePanelist(ctx context.Context, webinarID int64, panelistID string) (*http.Response, error)
+	RemoveAllPanelists(ctx context.Context, webinarID int64) (*http.Response, error)
+
+	ListRegistrants(ctx context.Context, webinarID int64, opts *RegistrantsListOptions) (*RegistrantsListResponse, *http.Response, error)
+	GetRegistrant(ctx context.Context, webinarID int64, registrantID string, opts *RegistrantsGetOptions) (*Registrant, *http.Response, error)
+	AddRegistrant(ctx context.Context, webinarID int64, opts *RegistrantsAddOptions) (*RegistrantsAddResponse, *http.Response, error)
+	BatchAddRegistrants(ctx context.Context, webinarID int64, opts *RegistrantsBatchAddOptions) (*RegistrantsBatchAddResponse, *http.Response, error)
+	UpdateRegistrantsStatus(ctx context.Context, webinarID int64, opts *RegistrantsUpdateStatusOptions) (*http.Response, error)
+	DeleteRegistrant(ctx context.Context, webinarID int64, registrantID string, opts *RegistrantsDeleteOptions) (*http.Response, error)
+	GetRegistrationQuestions(ctx context.Context, webinarID int64) (*RegistrationQuestions, *http.Response, error)
+	UpdateRegistrationQuestions(ctx context.Context, webinarID int64, opts *RegistrationQuestions) (*http.Response, error)
+	ListAbsentees(ctx context.Context, webinarID string, opts *RegistrantsListOptions) (*RegistrantsListResponse, *http.Response, error)
+
+	ListPolls(ctx context.Context, webinarID int64) (*PollsListResponse, *http.Response, error)
+	GetPoll(ctx context.Context, webinarID int64, pollID string) (*Poll, *http.Response, error)
+	CreatePoll(ctx context.Context, webinarID int64, opts *PollsCreateOptions) (*Poll, *http.Response, error)
+	UpdatePoll(ctx context.Context, webinarID int64, pollID string, opts *PollsUpdateOptions) (*http.Response, error)
+	DeletePoll(ctx context.Context, webinarID int64, pollID string) (*http.Response, error)
+
+	ListQA(ctx context.Context, webinarID string) (*WebinarsListQAResponse, *http.Response, error)
+	ListTrackingSources(ctx context.Context, webinarID int64) (*WebinarsListTrackingSourcesResponse, *http.Response, error)
+}
+
+type WebinarsService struct {
+	client *Client
+}
+
+var _ WebinarsServicer = (*WebinarsService)(nil)
+
+func webinarPath(webinarID int64) string {
+	return "/webinars/" + url.QueryEscape(strconv.FormatInt(webinarID, 10))
+}
+
+type WebinarsListOptions struct {
+	*PaginationOptions `url:",omitempty"`
+
+	Type *string `url:"type,omitempty"`
+}
+
+type WebinarsListResponse struct {
+	*PaginationResponse
+
+	Webinars []*WebinarsListItem `json:"webinars"`
+}
+
+type WebinarsListItem struct {
+	Agenda     string    `json:"agenda"`
+	CreatedAt  time.Time `json:"created_at"`
+	Duration   int       `json:"duration"`
+	HostID     string    `json:"host_id"`
+	ID         int64     `json:"id"`
+	IsSimulive bool      `json:"is_simulive"`
+	JoinURL    string    `json:"join_url"`
+	StartTime  StartTime `json:"start_time"`
+	Timezone   string    `json:"timezone"`
+	Topic      string    `json:"topic"`
+	Type       int       `json:"type"`
+	UUID       string    `json:"uuid"`
+}
+
+func (w *WebinarsService) List(ctx context.Context, userID string, opts *WebinarsListOptions) (*WebinarsListResponse, *http.Response, error) {
+	out := &WebinarsListResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, "/users/"+url.QueryEscape(userID)+"/webinars", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// Webinar is returned when getting or creating a webinar. Recurrence, occurrences, tracking fields and the settings that
+// webinars have in common with meetings use the meeting types.
+type Webinar struct {
+	Agenda          string                                 `json:"agenda"`
+	CreatedAt       time.Time                              `json:"created_at"`
+	Duration        int                                    `json:"duration"`
+	HostEmail       string                                 `json:"host_email"`
+	HostID          string                                 `json:"host_id"`
+	ID              int64                                  `json:"id"`
+	IsSimulive      bool                                   `json:"is_simulive"`
+	JoinURL         string                                 `json:"join_url"`
+	Occurrences     []*MeetingsCreateResponseOccurances    `json:"occurrences"`
+	Password        string                                 `json:"password"`
+	Recurrence      *MeetingsCreateResponseRecurrance      `json:"recurrence"`
+	RegistrationURL string                                 `json:"registration_url"`
+	Settings        *WebinarSettings                       `json:"settings"`
+	StartTime       StartTime                              `json:"start_time"`
+	StartURL        string                                 `json:"start_url"`
+	Timezone        string                                 `json:"timezone"`
+	Topic           string                                 `json:"topic"`
+	TrackingFields  []*MeetingsCreateResponseTrackingField `json:"tracking_fields"`
+	Type            int                                    `json:"type"`
+	UUID            string                                 `json:"uuid"`
+}
+
+type WebinarSettings struct {
+	*MeetingCreateResponseSettings
+
+	AttendeesAndPanelistsReminderEmailNotification *WebinarSettingsEmailNotification `json:"attendees_and_panelists_reminder_email_notification,omitempty"`
+	FollowUpAbsenteesEmailNotification             *WebinarSettingsEmailNotification `json:"follow_up_absentees_email_notification,omitempty"`
+	FollowUpAttendeesEmailNotification             *WebinarSettingsEmailNotification `json:"follow_up_attendees_email_notification,omitempty"`
+	HDVideo                                        bool                              `json:"hd_video,omitempty"`
+	HDVideoForAttendees                            bool                              `json:"hd_video_for_attendees,omitempty"`
+	NotifyRegistrants                              bool                              `json:"notify_registrants,omitempty"`
+	OnDemand                                       bool                              `json:"on_demand,omitempty"`
+	PanelistAuthentication                         bool                              `json:"panelist_authentication,omitempty"`
+	PanelistsInvitationEmailNotification           bool                              `json:"panelists_invitation_email_notification,omitempty"`
+	PanelistsVideo                                 bool                              `json:"panelists_video,omitempty"`
+	PostWebinarSurvey                              bool                              `json:"post_webinar_survey,omitempty"`
+	PracticeSession                                bool                              `json:"practice_session,omitempty"`
+	QuestionAndAnswer                              *WebinarSettingsQuestionAndAnswer `json:"question_and_answer,omitempty"`
+	Send1080pVideoToAttendees                      bool                              `json:"send_1080p_video_to_attendees,omitempty"`
+	SurveyURL                                      string                            `json:"survey_url,omitempty"`
+}
+
+type WebinarSettingsEmailNotification struct {
+	Enable bool `json:"enable,omitempty"`
+	Type   int  `json:"type,omitempty"`
+}
+
+type WebinarSettingsQuestionAndAnswer struct {
+	AllowAnonymousQuestions bool   `json:"allow_anonymous_questions,omitempty"`
+	AllowAutoReply          bool   `json:"allow_auto_reply,omitempty"`
+	AllowSubmitQuestions    bool   `json:"allow_submit_questions,omitempty"`
+	AnswerQuestions         string `json:"answer_questions,omitempty"`
+	AttendeesCanComment     bool   `json:"attendees_can_comment,omitempty"`
+	AttendeesCanUpvote      bool   `json:"attendees_can_upvote,omitempty"`
+	AutoReplyText           string `json:"auto_reply_text,omitempty"`
+	Enable                  bool   `json:"enable,omitempty"`
+}
+
+type WebinarsGetOptions struct {
+	OccurrenceID            *string `url:"occurrence_id,omitempty"`
+	ShowPreviousOccurrences *bool   `url:"show_previous_occurrences,omitempty"`
+}
+
+func (w *WebinarsService) Get(ctx context.Context, webinarID int64, opts *WebinarsGetOptions) (*Webinar, *http.Response, error) {
+	out := &Webinar{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type WebinarsCreateOptions struct {
+	Agenda          *string                                `json:"agenda,omitempty"`
+	DefaultPasscode *bool                                  `json:"default_passcode,omitempty"`
+	Duration        *int                                   `json:"duration,omitempty"`
+	IsSimulive      *bool                                  `json:"is_simulive,omitempty"`
+	Password        *string                                `json:"password,omitempty"`
+	Recurrence      *MeetingsCreateOptionsRecurrence       `json:"recurrence,omitempty"`
+	ScheduleFor     *string                                `json:"schedule_for,omitempty"`
+	Settings        *WebinarsCreateOptionsSettings         `json:"settings,omitempty"`
+	StartTime       *StartTime                             `json:"start_time,omitempty"`
+	TemplateID      *string                                `json:"template_id,omitempty"`
+	Timezone        *string                                `json:"timezone,omitempty"`
+	Topic           *string                                `json:"topic,omitempty"`
+	TrackingFields  []*MeetingsCreateResponseTrackingField `json:"tracking_fields,omitempty"`
+	Type            *int                                   `json:"type,omitempty"`
+}
+
+// MarshalJSON sets the timezone to the start time's when it is sent as a wall-clock time, failing when the two differ.
+func (w *WebinarsCreateOptions) MarshalJSON() ([]byte, error) {
+	// A nil options embedded in WebinarsUpdateOptions updates nothing.
+	if w == nil {
+		return []byte("{}"), nil
+	}
+
+	type alias WebinarsCreateOptions
+	opts := alias(*w)
+
+	var err error
+	opts.Timezone, err = startTimeTimezone(w.StartTime, w.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&opts)
+}
+
+type WebinarsCreateOptionsSettings struct {
+	AllowMultipleDevices               *bool                                                             `json:"allow_multiple_devices,omitempty"`
+	AlternativeHosts                   *string                                                           `json:"alternative_hosts,omitempty"`
+	ApprovalType                       *int                                                              `json:"approval_type,omitempty"`
+	ApprovedOrDeniedCountriesOrRegions *MeetingsCreateResponseSettingsApprovedOrDeniedCountriesOrRegions `json:"approved_or_denied_countries_or_regions,omitempty"`
+	Audio                              *string                                                           `json:"audio,omitempty"`
+	AuthenticationDomains              *string                                                           `json:"authentication_domains,omitempty"`
+	AuthenticationOption               *string                                                           `json:"authentication_option,omitempty"`
+	AutoRecording                      *string                                                           `json:"auto_recording,omitempty"`
+	CloseRegistration                  *bool                                                             `json:"close_registration,omitempty"`
+	ContactEmail                       *string                                                           `json:"contact_email,omitempty"`
+	ContactName                        *string                                                           `json:"contact_name,omitempty"`
+	EmailLanguage                      *string                                                           `json:"email_language,omitempty"`
+	HDVideo                            *bool                                                             `json:"hd_video,omitempty"`
+	HostVideo                          *bool                                                             `json:"host_video,omitempty"`
+	LanguageInterpretation             *MeetingsCreateResponseSettingsLanguageInterpretation             `json:"language_interpretation,omitempty"`
+	MeetingAuthentication              *bool                                                             `json:"meeting_authentication,omitempty"`
+	OnDemand                           *bool                                                             `json:"on_demand,omitempty"`
+	PanelistsVideo                     *bool                                                             `json:"panelists_video,omitempty"`
+	PracticeSession                    *bool                                                             `json:"practice_session,omitempty"`
+	QuestionAndAnswer                  *WebinarSettingsQuestionAndAnswer                                 `json:"question_and_answer,omitempty"`
+	RegistrantsEmailNotification       *bool                                                             `json:"registrants_email_notification,omitempty"`
+	RegistrationType                   *int                                                              `json:"registration_type,omitempty"`
+	ShowShareButton                    *bool                                                             `json:"show_share_button,omitempty"`
+}
+
+func (w *WebinarsService) Create(ctx context.Context, userID string, opts *WebinarsCreateOptions) (*Webinar, *http.Response, error) {
+	out := &Webinar{}
+
+	res, err := w.client.request(ctx, http.MethodPost, "/users/"+url.QueryEscape(userID)+"/webinars", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+// WebinarsUpdateOptions accepts the same fields as WebinarsCreateOptions. Only the fields that are set are updated.
+type WebinarsUpdateOptions struct {
+	*WebinarsCreateOptions `url:"-"`
+
+	OccurrenceID *string `url:"occurrence_id,omitempty" json:"-"`
+}
+
+func (w *WebinarsService) Update(ctx context.Context, webinarID int64, opts *WebinarsUpdateOptions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodPatch, webinarPath(webinarID), opts, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+type WebinarsDeleteOptions struct {
+	CancelWebinarReminder *bool   `url:"cancel_webinar_reminder,omitempty"`
+	OccurrenceID          *string `url:"occurrence_id,omitempty"`
+}
+
+func (w *WebinarsService) Delete(ctx context.Context, webinarID int64, opts *WebinarsDeleteOptions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodDelete, webinarPath(webinarID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+type WebinarsUpdateStatusOptions struct {
+	// Action is the only supported status action, "end".
+	Action string `json:"action"`
+}
+
+func (w *WebinarsService) UpdateStatus(ctx context.Context, webinarID int64, opts *WebinarsUpdateStatusOptions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodPut, webinarPath(webinarID)+"/status", nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+type WebinarsListPanelistsResponse struct {
+	Panelists    []*WebinarsPanelist `json:"panelists"`
+	TotalRecords int                 `json:"total_records"`
+}
+
+type WebinarsPanelist struct {
+	Email               string `json:"email"`
+	ID                  string `json:"id"`
+	JoinURL             string `json:"join_url"`
+	Name                string `json:"name"`
+	NameTagDescription  string `json:"name_tag_description"`
+	NameTagID           string `json:"name_tag_id"`
+	NameTagName         string `json:"name_tag_name"`
+	NameTagPronouns     string `json:"name_tag_pronouns"`
+	VirtualBackgroundID string `json:"virtual_background_id"`
+}
+
+func (w *WebinarsService) ListPanelists(ctx context.Context, webinarID int64) (*WebinarsListPanelistsResponse, *http.Response, error) {
+	out := &WebinarsListPanelistsResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/panelists", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type WebinarsAddPanelistsOptions struct {
+	Panelists []*WebinarsAddPanelistsOptionsPanelist `json:"panelists"`
+}
+
+type WebinarsAddPanelistsOptionsPanelist struct {
+	Email               string  `json:"email"`
+	Name                string  `json:"name"`
+	NameTagDescription  *string `json:"name_tag_description,omitempty"`
+	NameTagID           *string `json:"name_tag_id,omitempty"`
+	NameTagName         *string `json:"name_tag_name,omitempty"`
+	NameTagPronouns     *string `json:"name_tag_pronouns,omitempty"`
+	VirtualBackgroundID *string `json:"virtual_background_id,omitempty"`
+}
+
+type WebinarsAddPanelistsResponse struct {
+	ID        string    `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (w *WebinarsService) AddPanelists(ctx context.Context, webinarID int64, opts *WebinarsAddPanelistsOptions) (*WebinarsAddPanelistsResponse, *http.Response, error) {
+	out := &WebinarsAddPanelistsResponse{}
+
+	res, err := w.client.request(ctx, http.MethodPost, webinarPath(webinarID)+"/panelists", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) RemovePanelist(ctx context.Context, webinarID int64, panelistID string) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodDelete, webinarPath(webinarID)+"/panelists/"+url.QueryEscape(panelistID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (w *WebinarsService) RemoveAllPanelists(ctx context.Context, webinarID int64) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodDelete, webinarPath(webinarID)+"/panelists", nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (w *WebinarsService) ListRegistrants(ctx context.Context, webinarID int64, opts *RegistrantsListOptions) (*RegistrantsListResponse, *http.Response, error) {
+	out := &RegistrantsListResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/registrants", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) GetRegistrant(ctx context.Context, webinarID int64, registrantID string, opts *RegistrantsGetOptions) (*Registrant, *http.Response, error) {
+	out := &Registrant{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/registrants/"+url.QueryEscape(registrantID), opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) AddRegistrant(ctx context.Context, webinarID int64, opts *RegistrantsAddOptions) (*RegistrantsAddResponse, *http.Response, error) {
+	out := &RegistrantsAddResponse{}
+
+	res, err := w.client.request(ctx, http.MethodPost, webinarPath(webinarID)+"/registrants", opts, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) BatchAddRegistrants(ctx context.Context, webinarID int64, opts *RegistrantsBatchAddOptions) (*RegistrantsBatchAddResponse, *http.Response, error) {
+	out := &RegistrantsBatchAddResponse{}
+
+	res, err := w.client.request(ctx, http.MethodPost, webinarPath(webinarID)+"/batch_registrants", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) UpdateRegistrantsStatus(ctx context.Context, webinarID int64, opts *RegistrantsUpdateStatusOptions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodPut, webinarPath(webinarID)+"/registrants/status", opts, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (w *WebinarsService) DeleteRegistrant(ctx context.Context, webinarID int64, registrantID string, opts *RegistrantsDeleteOptions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodDelete, webinarPath(webinarID)+"/registrants/"+url.QueryEscape(registrantID), opts, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (w *WebinarsService) GetRegistrationQuestions(ctx context.Context, webinarID int64) (*RegistrationQuestions, *http.Response, error) {
+	out := &RegistrationQuestions{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/registrants/questions", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) UpdateRegistrationQuestions(ctx context.Context, webinarID int64, opts *RegistrationQuestions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodPatch, webinarPath(webinarID)+"/registrants/questions", nil, opts.body(), nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+// ListAbsentees lists the registrants who did not attend a past webinar. webinarID may be a webinar ID or a webinar instance UUID.
+func (w *WebinarsService) ListAbsentees(ctx context.Context, webinarID string, opts *RegistrantsListOptions) (*RegistrantsListResponse, *http.Response, error) {
+	out := &RegistrantsListResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, "/past_webinars/"+escapeUUID(webinarID)+"/absentees", opts, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) ListPolls(ctx context.Context, webinarID int64) (*PollsListResponse, *http.Response, error) {
+	out := &PollsListResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/polls", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) GetPoll(ctx context.Context, webinarID int64, pollID string) (*Poll, *http.Response, error) {
+	out := &Poll{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/polls/"+url.QueryEscape(pollID), nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) CreatePoll(ctx context.Context, webinarID int64, opts *PollsCreateOptions) (*Poll, *http.Response, error) {
+	out := &Poll{}
+
+	res, err := w.client.request(ctx, http.MethodPost, webinarPath(webinarID)+"/polls", nil, opts, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+func (w *WebinarsService) UpdatePoll(ctx context.Context, webinarID int64, pollID string, opts *PollsUpdateOptions) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodPut, webinarPath(webinarID)+"/polls/"+url.QueryEscape(pollID), nil, opts, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+func (w *WebinarsService) DeletePoll(ctx context.Context, webinarID int64, pollID string) (*http.Response, error) {
+	res, err := w.client.request(ctx, http.MethodDelete, webinarPath(webinarID)+"/polls/"+url.QueryEscape(pollID), nil, nil, nil)
+	if err != nil {
+		return res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return res, nil
+}
+
+type WebinarsListQAResponse struct {
+	ID        int64                     `json:"id"`
+	Questions []*WebinarsListQAQuestion `json:"questions"`
+	StartTime StartTime                 `json:"start_time"`
+	UUID      string                    `json:"uuid"`
+}
+
+type WebinarsListQAQuestion struct {
+	Email           string                          `json:"email"`
+	Name            string                          `json:"name"`
+	QuestionDetails []*WebinarsListQAQuestionDetail `json:"question_details"`
+}
+
+type WebinarsListQAQuestionDetail struct {
+	Answer   string `json:"answer"`
+	Question string `json:"question"`
+}
+
+// ListQA lists the questions and answers of a past webinar. webinarID may be a webinar ID or a webinar instance UUID.
+func (w *WebinarsService) ListQA(ctx context.Context, webinarID string) (*WebinarsListQAResponse, *http.Response, error) {
+	out := &WebinarsListQAResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, "/past_webinars/"+escapeUUID(webinarID)+"/qa", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}
+
+type WebinarsListTrackingSourcesResponse struct {
+	TotalRecords    int                       `json:"total_records"`
+	TrackingSources []*WebinarsTrackingSource `json:"tracking_sources"`
+}
+
+type WebinarsTrackingSource struct {
+	ID                string `json:"id"`
+	RegistrationCount int    `json:"registration_count"`
+	SourceName        string `json:"source_name"`
+	TrackingURL       string `json:"tracking_url"`
+	VisitorCount      int    `json:"visitor_count"`
+}
+
+func (w *WebinarsService) ListTrackingSources(ctx context.Context, webinarID int64) (*WebinarsListTrackingSourcesResponse, *http.Response, error) {
+	out := &WebinarsListTrackingSourcesResponse{}
+
+	res, err := w.client.request(ctx, http.MethodGet, webinarPath(webinarID)+"/tracking_sources", nil, nil, out)
+	if err != nil {
+		return nil, res, fmt.Errorf("making HTTP request: %w", err)
+	}
+
+	return out, res, nil
+}